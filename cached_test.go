@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CachedClockSuite struct {
+	ChrononSuite
+}
+
+func (suite *CachedClockSuite) TestFakeClock() {
+	fc := suite.newFakeClock()
+	cc := NewCachedClock(fc, time.Second)
+	defer cc.Stop()
+
+	start := fc.Now()
+	suite.Equal(start, cc.Now())
+
+	// moving less than the resolution does not change the cached time
+	fc.Add(500 * time.Millisecond)
+	suite.Equal(start, cc.Now())
+	suite.Equal(time.Second, cc.Until(start.Add(time.Second)))
+	suite.Equal(time.Second, cc.Since(start.Add(-time.Second)))
+
+	fc.Add(500 * time.Millisecond)
+	suite.Eventually(
+		func() bool { return cc.Now().Equal(fc.Now()) },
+		time.Second,
+		time.Millisecond,
+	)
+
+	fc.Add(time.Millisecond)
+	suite.Equal(fc.Now(), cc.Refresh())
+	suite.Equal(fc.Now(), cc.Now())
+}
+
+func (suite *CachedClockSuite) TestStop() {
+	fc := suite.newFakeClock()
+	cc := NewCachedClock(fc, time.Second)
+	cc.Stop()
+	cc.Stop()
+
+	fc.Add(time.Millisecond)
+	suite.Equal(fc.Now(), cc.Now())
+	suite.Equal(fc.Now(), cc.Refresh())
+	fc.Add(time.Millisecond)
+	suite.Equal(fc.Now(), cc.Now())
+}
+
+func (suite *CachedClockSuite) TestSystemClock() {
+	cc := NewCachedClock(nil, 0)
+	defer cc.Stop()
+
+	before := time.Now()
+	suite.Eventually(
+		func() bool { return !cc.Now().Before(before) },
+		time.Second,
+		time.Millisecond,
+	)
+
+	suite.WithinDuration(time.Now(), cc.Now(), 100*time.Millisecond)
+}
+
+func (suite *CachedClockSuite) TestDelegates() {
+	fc := suite.newFakeClock()
+	cc := NewCachedClock(fc, time.Hour)
+	defer cc.Stop()
+
+	t := cc.NewTimer(time.Second)
+	after := cc.After(time.Second)
+	called := make(chan struct{})
+	cc.AfterFunc(time.Second, func() { close(called) })
+	ticker := cc.NewTicker(time.Second)
+	tick := cc.Tick(time.Second)
+
+	done := make(chan struct{})
+	onSleep := make(chan Sleeper, 1)
+	fc.NotifyOnSleep(onSleep)
+	go func() {
+		defer close(done)
+		cc.Sleep(time.Second)
+	}()
+
+	suite.requireReceive(onSleep, WaitALittle)
+	fc.Add(time.Second)
+	suite.requireReceiveEqual(t.C(), fc.Now(), Immediate)
+	suite.requireReceiveEqual(after, fc.Now(), Immediate)
+	suite.requireSignal(called, Immediate)
+	suite.requireReceiveEqual(ticker.C(), fc.Now(), Immediate)
+	suite.requireReceiveEqual(tick, fc.Now(), Immediate)
+	suite.requireSignal(done, WaitALittle)
+	ticker.Stop()
+}
+
+func TestCachedClock(t *testing.T) {
+	suite.Run(t, new(CachedClockSuite))
+}
+
+var benchmarkTime time.Time
+
+func BenchmarkSystemClockNow(b *testing.B) {
+	c := SystemClock()
+	for i := 0; i < b.N; i++ {
+		benchmarkTime = c.Now()
+	}
+}
+
+func BenchmarkCachedClockNow(b *testing.B) {
+	cc := NewCachedClock(nil, 0)
+	defer cc.Stop()
+
+	var c Clock = cc
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchmarkTime = c.Now()
+	}
+}
+
+func BenchmarkSystemClockNowParallel(b *testing.B) {
+	c := SystemClock()
+	b.RunParallel(func(pb *testing.PB) {
+		var t time.Time
+		for pb.Next() {
+			t = c.Now()
+		}
+
+		_ = t
+	})
+}
+
+func BenchmarkCachedClockNowParallel(b *testing.B) {
+	cc := NewCachedClock(nil, 0)
+	defer cc.Stop()
+
+	var c Clock = cc
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var t time.Time
+		for pb.Next() {
+			t = c.Now()
+		}
+
+		_ = t
+	})
+}
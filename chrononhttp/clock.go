@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chrononhttp
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/xmidt-org/chronon"
+)
+
+const (
+	// TimeHeader selects a clock frozen at the given time, in RFC 3339 format.
+	TimeHeader = "X-Chronon-Time"
+
+	// OffsetHeader selects a clock that runs ahead of the configured clock by the given
+	// amount, in time.ParseDuration format.  Negative offsets run behind.
+	OffsetHeader = "X-Chronon-Offset"
+
+	// ClockHeader selects one of the named clocks in ClockConfig.Clocks.
+	ClockHeader = "X-Chronon-Clock"
+)
+
+var (
+	// ErrMultipleClockHeaders indicates that a request specified more than one of
+	// TimeHeader, OffsetHeader, and ClockHeader.
+	ErrMultipleClockHeaders = errors.New("only one chronon clock header may be specified")
+
+	// ErrUnknownClock indicates that the ClockHeader named a clock that is not configured.
+	ErrUnknownClock = errors.New("unknown chronon clock")
+)
+
+// ClockConfig configures the middleware returned by NewClockMiddleware.
+type ClockConfig struct {
+	// Clock is the Clock associated with each request's context.  If unset,
+	// chronon.SystemClock() is used.
+	Clock chronon.Clock
+
+	// AllowOverride enables the TimeHeader, OffsetHeader, and ClockHeader request
+	// headers, which select a different clock for a single request.  When false, which
+	// is the default, those headers are ignored.  This must never be enabled in production,
+	// since it lets clients control the time a server observes.
+	AllowOverride bool
+
+	// Clocks are the named clocks that requests may select with ClockHeader.
+	// Typically, these are FakeClocks driven by a test harness.
+	Clocks map[string]chronon.Clock
+}
+
+// clockMiddleware associates a Clock with each request.
+type clockMiddleware struct {
+	next http.Handler
+	cfg  ClockConfig
+}
+
+// NewClockMiddleware returns middleware that associates a Clock with each request's
+// context, so that handlers can obtain it with chronon.Get.
+//
+// If cfg.AllowOverride is set, a request may select its own clock with one of the clock
+// headers.  A request with TimeHeader gets a chronon.Frozen clock fixed at that time,
+// whose timers never fire.  A request with OffsetHeader gets a clock that runs ahead of
+// or behind the configured clock, and whose timers behave normally.  A request with
+// ClockHeader gets the named clock from cfg.Clocks.  This allows end-to-end tests to
+// exercise time-dependent paths, such as token expiry, against a live server.  Requests
+// with invalid headers are rejected with http.StatusBadRequest.
+func NewClockMiddleware(cfg ClockConfig) func(http.Handler) http.Handler {
+	if cfg.Clock == nil {
+		cfg.Clock = chronon.SystemClock()
+	}
+
+	return func(next http.Handler) http.Handler {
+		return clockMiddleware{
+			next: next,
+			cfg:  cfg,
+		}
+	}
+}
+
+// requestClock determines the Clock for the given request.
+func (cm clockMiddleware) requestClock(request *http.Request) (chronon.Clock, error) {
+	if !cm.cfg.AllowOverride {
+		return cm.cfg.Clock, nil
+	}
+
+	var (
+		at     = request.Header.Get(TimeHeader)
+		offset = request.Header.Get(OffsetHeader)
+		id     = request.Header.Get(ClockHeader)
+	)
+
+	switch {
+	case len(at) > 0 && len(offset) > 0, len(at) > 0 && len(id) > 0, len(offset) > 0 && len(id) > 0:
+		return nil, ErrMultipleClockHeaders
+
+	case len(at) > 0:
+		t, err := time.Parse(time.RFC3339Nano, at)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s header: %w", TimeHeader, err)
+		}
+
+		return chronon.Frozen(t), nil
+
+	case len(offset) > 0:
+		d, err := time.ParseDuration(offset)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s header: %w", OffsetHeader, err)
+		}
+
+		return chronon.Offset(cm.cfg.Clock, d), nil
+
+	case len(id) > 0:
+		if c, ok := cm.cfg.Clocks[id]; ok {
+			return c, nil
+		}
+
+		return nil, fmt.Errorf("%w: %s", ErrUnknownClock, id)
+
+	default:
+		return cm.cfg.Clock, nil
+	}
+}
+
+func (cm clockMiddleware) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	c, err := cm.requestClock(request)
+	if err != nil {
+		http.Error(response, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	cm.next.ServeHTTP(response, request.WithContext(chronon.With(request.Context(), c)))
+}
@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chrononhttp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/xmidt-org/chronon"
+)
+
+type ClockMiddlewareSuite struct {
+	suite.Suite
+
+	now   time.Time
+	base  *chronon.FakeClock
+	named *chronon.FakeClock
+}
+
+func (suite *ClockMiddlewareSuite) SetupTest() {
+	suite.now = time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	suite.base = chronon.NewFakeClock(suite.now)
+	suite.named = chronon.NewFakeClock(suite.now.Add(-time.Hour))
+}
+
+// serve sends a request with the given headers through the middleware, returning
+// the response and the clock observed by the handler.
+func (suite *ClockMiddlewareSuite) serve(cfg ClockConfig, headers map[string]string) (*httptest.ResponseRecorder, chronon.Clock) {
+	var observed chronon.Clock
+	handler := NewClockMiddleware(cfg)(
+		http.HandlerFunc(func(_ http.ResponseWriter, request *http.Request) {
+			observed = chronon.Get(request.Context())
+		}),
+	)
+
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	for name, value := range headers {
+		request.Header.Set(name, value)
+	}
+
+	response := httptest.NewRecorder()
+	handler.ServeHTTP(response, request)
+	return response, observed
+}
+
+func (suite *ClockMiddlewareSuite) config() ClockConfig {
+	return ClockConfig{
+		Clock:         suite.base,
+		AllowOverride: true,
+		Clocks: map[string]chronon.Clock{
+			"named": suite.named,
+		},
+	}
+}
+
+func (suite *ClockMiddlewareSuite) TestDefault() {
+	response, observed := suite.serve(ClockConfig{}, map[string]string{TimeHeader: suite.now.Format(time.RFC3339)})
+	suite.Equal(http.StatusOK, response.Code)
+	suite.True(chronon.IsSystemClock(observed))
+}
+
+func (suite *ClockMiddlewareSuite) TestOverrideDisabled() {
+	cfg := suite.config()
+	cfg.AllowOverride = false
+
+	for _, header := range []string{TimeHeader, OffsetHeader, ClockHeader} {
+		suite.Run(header, func() {
+			response, observed := suite.serve(cfg, map[string]string{header: "invalid"})
+			suite.Equal(http.StatusOK, response.Code)
+			suite.Same(suite.base, observed)
+		})
+	}
+}
+
+func (suite *ClockMiddlewareSuite) TestNoHeaders() {
+	response, observed := suite.serve(suite.config(), nil)
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Same(suite.base, observed)
+}
+
+func (suite *ClockMiddlewareSuite) TestTime() {
+	frozen := suite.now.Add(24 * time.Hour)
+	response, observed := suite.serve(suite.config(), map[string]string{TimeHeader: frozen.Format(time.RFC3339Nano)})
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Require().NotNil(observed)
+	suite.True(frozen.Equal(observed.Now()))
+
+	suite.base.Add(time.Hour)
+	suite.True(frozen.Equal(observed.Now()))
+
+	_, isFake := observed.(*chronon.FakeClock)
+	suite.False(isFake)
+}
+
+func (suite *ClockMiddlewareSuite) TestOffset() {
+	response, observed := suite.serve(suite.config(), map[string]string{OffsetHeader: "-90m"})
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Require().NotNil(observed)
+	suite.Equal(suite.now.Add(-90*time.Minute), observed.Now())
+
+	suite.base.Add(time.Minute)
+	suite.Equal(suite.now.Add(-89*time.Minute), observed.Now())
+	suite.Equal(time.Minute, observed.Since(suite.now.Add(-90*time.Minute)))
+	suite.Equal(time.Minute, observed.Until(suite.now.Add(-88*time.Minute)))
+
+	// timers are not affected by the offset
+	t := observed.NewTimer(time.Second)
+	suite.base.Add(time.Second)
+	suite.Len(t.C(), 1)
+}
+
+func (suite *ClockMiddlewareSuite) TestClock() {
+	response, observed := suite.serve(suite.config(), map[string]string{ClockHeader: "named"})
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Same(suite.named, observed)
+}
+
+func (suite *ClockMiddlewareSuite) TestInvalid() {
+	for name, headers := range map[string]map[string]string{
+		"Time":         {TimeHeader: "invalid"},
+		"Offset":       {OffsetHeader: "invalid"},
+		"UnknownClock": {ClockHeader: "nosuch"},
+		"TimeOffset":   {TimeHeader: suite.now.Format(time.RFC3339), OffsetHeader: "1h"},
+		"TimeClock":    {TimeHeader: suite.now.Format(time.RFC3339), ClockHeader: "named"},
+		"OffsetClock":  {OffsetHeader: "1h", ClockHeader: "named"},
+	} {
+		suite.Run(name, func() {
+			response, observed := suite.serve(suite.config(), headers)
+			suite.Equal(http.StatusBadRequest, response.Code)
+			suite.Nil(observed)
+		})
+	}
+}
+
+func TestClockMiddleware(t *testing.T) {
+	suite.Run(t, new(ClockMiddlewareSuite))
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chrononhttp
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/xmidt-org/chronon"
+)
+
+// TimeoutHeader carries the time remaining before a request's deadline, in
+// time.ParseDuration format.  The budget is relative rather than absolute, so
+// that hosts do not need to agree on the time.
+const TimeoutHeader = "X-Chronon-Timeout"
+
+// DeadlineTransport is an http.RoundTripper that enforces request deadlines through
+// a chronon.Clock and propagates each request's remaining budget to the server in
+// TimeoutHeader.  With a *chronon.FakeClock, client timeouts can be tested in
+// virtual time.
+//
+// A request's deadline is taken from its context.  Deadlines are measured against the
+// Clock, so a context whose deadline is enforced by some other clock may expire at a
+// different time than the Clock predicts.
+type DeadlineTransport struct {
+	// Next is the RoundTripper that sends requests.  If unset, http.DefaultTransport is used.
+	Next http.RoundTripper
+
+	// Clock measures and enforces deadlines.  If unset, the Clock associated with each
+	// request's context is used.  See chronon.Get.
+	Clock chronon.Clock
+
+	// Timeout is the budget for each request, which is reduced further if the request's
+	// context has an earlier deadline.  If nonpositive, only the context's deadline applies.
+	Timeout time.Duration
+}
+
+var _ http.RoundTripper = (*DeadlineTransport)(nil)
+
+// RoundTrip sends a request with its remaining budget.  If the budget is already
+// exhausted, the request is not sent and context.DeadlineExceeded is returned.
+func (dt *DeadlineTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	var (
+		next   = dt.Next
+		clock  = dt.Clock
+		ctx    = request.Context()
+		cancel = context.CancelFunc(func() {})
+	)
+
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	if clock == nil {
+		clock = chronon.Get(ctx)
+	}
+
+	if dt.Timeout > 0 {
+		ctx, cancel = chronon.WithTimeout(ctx, clock, dt.Timeout)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		cancel()
+		return next.RoundTrip(request)
+	}
+
+	remaining := clock.Until(deadline)
+	if remaining <= 0 {
+		cancel()
+		return nil, context.DeadlineExceeded
+	}
+
+	request = request.Clone(ctx)
+	request.Header.Set(TimeoutHeader, remaining.String())
+	response, err := next.RoundTrip(request)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// the deadline must apply until the body has been read
+	response.Body = cancelBody{ReadCloser: response.Body, cancel: cancel}
+	return response, nil
+}
+
+// cancelBody releases a request's context once the response body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (cb cancelBody) Close() error {
+	err := cb.ReadCloser.Close()
+	cb.cancel()
+	return err
+}
+
+// deadlineMiddleware turns TimeoutHeader into a context deadline.
+type deadlineMiddleware struct {
+	next http.Handler
+}
+
+// DeadlineMiddleware returns an http.Handler that applies the budget in each request's
+// TimeoutHeader as a deadline on the request's context.  The deadline is enforced by the
+// Clock associated with the request's context, so this middleware should run after the
+// middleware returned by NewClockMiddleware.  See chronon.ContextWithTimeout.
+//
+// The deadline is computed when the request arrives, so passing the request's context to a
+// DeadlineTransport sends the downstream server whatever budget remains after this hop.
+// Requests without TimeoutHeader are passed through unchanged.  Requests with an invalid
+// TimeoutHeader are rejected with http.StatusBadRequest.
+func DeadlineMiddleware(next http.Handler) http.Handler {
+	return deadlineMiddleware{next: next}
+}
+
+func (dm deadlineMiddleware) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	value := request.Header.Get(TimeoutHeader)
+	if len(value) == 0 {
+		dm.next.ServeHTTP(response, request)
+		return
+	}
+
+	budget, err := time.ParseDuration(value)
+	if err != nil {
+		http.Error(response, fmt.Sprintf("invalid %s header: %s", TimeoutHeader, err), http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := chronon.ContextWithTimeout(request.Context(), budget)
+	defer cancel()
+	dm.next.ServeHTTP(response, request.WithContext(ctx))
+}
@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chrononhttp
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/xmidt-org/chronon"
+)
+
+type DeadlineSuite struct {
+	suite.Suite
+
+	fc *chronon.FakeClock
+}
+
+func (suite *DeadlineSuite) SetupTest() {
+	suite.fc = chronon.NewFakeClock(time.Now())
+}
+
+// newServer starts a server whose requests use the fake clock and honor TimeoutHeader.
+func (suite *DeadlineSuite) newServer(h http.HandlerFunc) *httptest.Server {
+	server := httptest.NewServer(
+		NewClockMiddleware(ClockConfig{Clock: suite.fc})(
+			DeadlineMiddleware(h),
+		),
+	)
+
+	suite.T().Cleanup(server.Close)
+	return server
+}
+
+// newClient creates an HTTP client that enforces the given timeout with the fake clock.
+func (suite *DeadlineSuite) newClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Transport: &DeadlineTransport{
+			Clock:   suite.fc,
+			Timeout: timeout,
+		},
+	}
+}
+
+func (suite *DeadlineSuite) get(client *http.Client, ctx context.Context, url string) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	suite.Require().NoError(err)
+	return client.Do(request)
+}
+
+func (suite *DeadlineSuite) TestPropagate() {
+	budgets := make(chan string, 1)
+	server := suite.newServer(func(response http.ResponseWriter, request *http.Request) {
+		budgets <- request.Header.Get(TimeoutHeader)
+		deadline, ok := request.Context().Deadline()
+		suite.True(ok)
+		suite.Equal(5*time.Second, deadline.Sub(suite.fc.Now()))
+		io.WriteString(response, "ok")
+	})
+
+	response, err := suite.get(suite.newClient(5*time.Second), context.Background(), server.URL)
+	suite.Require().NoError(err)
+	body, err := io.ReadAll(response.Body)
+	suite.NoError(err)
+	suite.NoError(response.Body.Close())
+	suite.Equal("ok", string(body))
+	suite.Equal("5s", <-budgets)
+}
+
+func (suite *DeadlineSuite) TestNoDeadline() {
+	server := suite.newServer(func(response http.ResponseWriter, request *http.Request) {
+		suite.Empty(request.Header.Get(TimeoutHeader))
+		_, ok := request.Context().Deadline()
+		suite.False(ok)
+	})
+
+	transport := new(DeadlineTransport)
+	response, err := suite.get(&http.Client{Transport: transport}, context.Background(), server.URL)
+	suite.Require().NoError(err)
+	response.Body.Close()
+	suite.Equal(http.StatusOK, response.StatusCode)
+}
+
+func (suite *DeadlineSuite) TestContextDeadline() {
+	budgets := make(chan string, 1)
+	server := suite.newServer(func(_ http.ResponseWriter, request *http.Request) {
+		budgets <- request.Header.Get(TimeoutHeader)
+	})
+
+	// the context's deadline is earlier than the transport's timeout
+	ctx, cancel := chronon.WithTimeout(context.Background(), suite.fc, time.Second)
+	defer cancel()
+
+	response, err := suite.get(suite.newClient(time.Minute), ctx, server.URL)
+	suite.Require().NoError(err)
+	response.Body.Close()
+	suite.Equal("1s", <-budgets)
+
+	// the clock associated with the context is used when none is configured
+	client := &http.Client{Transport: new(DeadlineTransport)}
+	response, err = suite.get(client, chronon.With(ctx, suite.fc), server.URL)
+	suite.Require().NoError(err)
+	response.Body.Close()
+	suite.Equal("1s", <-budgets)
+
+	suite.fc.Add(time.Second)
+	_, err = suite.get(client, chronon.With(ctx, suite.fc), server.URL)
+	suite.ErrorIs(err, context.DeadlineExceeded)
+}
+
+func (suite *DeadlineSuite) TestExhausted() {
+	ctx, cancel := chronon.WithTimeout(context.Background(), chronon.NewFakeClock(suite.fc.Now()), time.Second)
+	defer cancel()
+
+	// the budget is measured on the transport's clock, which has already passed the deadline
+	suite.fc.Add(time.Minute)
+	_, err := suite.get(suite.newClient(0), ctx, "http://localhost")
+	suite.ErrorIs(err, context.DeadlineExceeded)
+}
+
+func (suite *DeadlineSuite) TestTimeout() {
+	waiting := make(chan struct{})
+	server := suite.newServer(func(_ http.ResponseWriter, request *http.Request) {
+		close(waiting)
+		<-request.Context().Done()
+	})
+
+	result := make(chan error, 1)
+	go func() {
+		_, err := suite.get(suite.newClient(5*time.Second), context.Background(), server.URL)
+		result <- err
+	}()
+
+	<-waiting
+	suite.fc.Add(5 * time.Second)
+	select {
+	case err := <-result:
+		suite.ErrorIs(err, context.DeadlineExceeded)
+	case <-time.After(time.Second):
+		suite.Fail("the request did not time out")
+	}
+}
+
+func (suite *DeadlineSuite) TestChain() {
+	budgets := make(chan string, 1)
+	downstream := suite.newServer(func(_ http.ResponseWriter, request *http.Request) {
+		budgets <- request.Header.Get(TimeoutHeader)
+	})
+
+	client := &http.Client{Transport: new(DeadlineTransport)}
+	upstream := suite.newServer(func(_ http.ResponseWriter, request *http.Request) {
+		// simulate work at this hop, which uses up part of the budget
+		suite.fc.Add(1500 * time.Millisecond)
+
+		response, err := suite.get(client, request.Context(), downstream.URL)
+		suite.Require().NoError(err)
+		response.Body.Close()
+	})
+
+	response, err := suite.get(suite.newClient(5*time.Second), context.Background(), upstream.URL)
+	suite.Require().NoError(err)
+	response.Body.Close()
+	suite.Equal("3.5s", <-budgets)
+}
+
+func (suite *DeadlineSuite) TestInvalidHeader() {
+	server := suite.newServer(func(http.ResponseWriter, *http.Request) {
+		suite.Fail("the handler should not have been called")
+	})
+
+	request, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	suite.Require().NoError(err)
+	request.Header.Set(TimeoutHeader, "invalid")
+
+	response, err := http.DefaultClient.Do(request)
+	suite.Require().NoError(err)
+	response.Body.Close()
+	suite.Equal(http.StatusBadRequest, response.StatusCode)
+}
+
+func TestDeadline(t *testing.T) {
+	suite.Run(t, new(DeadlineSuite))
+}
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+// Package chrononhttp integrates chronon clocks with net/http.
+package chrononhttp
+
+import (
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/xmidt-org/chronon"
+)
+
+// DebugPath is the conventional path for the handler returned by NewDebugHandler.
+const DebugPath = "/debug/chronon"
+
+// defaultMux is where RegisterDebug registers when no mux is supplied.  Tests replace
+// this so that they do not modify http.DefaultServeMux.
+var defaultMux = http.DefaultServeMux
+
+var debugTemplate = template.Must(template.New("debug").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<title>{{ .Path }}</title>
+<style>
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 2px 6px; text-align: left; }
+</style>
+</head>
+<body>
+<h1>chronon</h1>
+<p>Now: {{ .Now.Format "2006-01-02T15:04:05.000000000Z07:00" }}</p>
+<p>{{ len .Tracked }} live objects (<a href="?format=json">json</a>)</p>
+<table>
+<tr><th>ID</th><th>Kind</th><th>Interval</th><th>Next</th><th>Age</th><th>Fired</th><th>Site</th></tr>
+{{- range .Tracked }}
+<tr>
+<td>{{ .ID }}</td>
+<td>{{ .Kind }}</td>
+<td>{{ .Interval }}</td>
+<td>{{ .When.Format "2006-01-02T15:04:05.000Z07:00" }}</td>
+<td>{{ $.Age .Created }}</td>
+<td>{{ .Fired }}</td>
+<td title="{{ .Site.Function }}">{{ .Site }}</td>
+</tr>
+{{- end }}
+</table>
+</body>
+</html>
+`))
+
+// debugPage is the data rendered by the debug handler.
+type debugPage struct {
+	Path    string            `json:"-"`
+	Now     time.Time         `json:"now"`
+	Tracked []chronon.Tracked `json:"tracked"`
+}
+
+// Age returns how long ago the given time was, relative to this page's Now.
+func (dp debugPage) Age(created time.Time) time.Duration {
+	return dp.Now.Sub(created)
+}
+
+// debugHandler renders the live objects of a TrackingClock.
+type debugHandler struct {
+	tc *chronon.TrackingClock
+}
+
+// NewDebugHandler returns an http.Handler that renders the live timers, tickers, and
+// sleeps of the given TrackingClock.  Like the handlers in net/http/pprof, the output
+// is HTML by default.  JSON is rendered if the request has a format=json query
+// parameter or accepts application/json.
+func NewDebugHandler(tc *chronon.TrackingClock) http.Handler {
+	return debugHandler{
+		tc: tc,
+	}
+}
+
+// RegisterDebug registers the handler returned by NewDebugHandler at DebugPath on the given mux.
+// If mux is nil, http.DefaultServeMux is used.
+func RegisterDebug(mux *http.ServeMux, tc *chronon.TrackingClock) {
+	if mux == nil {
+		mux = defaultMux
+	}
+
+	mux.Handle(DebugPath, NewDebugHandler(tc))
+}
+
+func (dh debugHandler) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	page := debugPage{
+		Path:    request.URL.Path,
+		Now:     dh.tc.Now(),
+		Tracked: dh.tc.Tracked(),
+	}
+
+	response.Header().Set("X-Content-Type-Options", "nosniff")
+	if wantsJSON(request) {
+		response.Header().Set("Content-Type", "application/json; charset=utf-8")
+		json.NewEncoder(response).Encode(page) // nolint:errcheck
+		return
+	}
+
+	response.Header().Set("Content-Type", "text/html; charset=utf-8")
+	debugTemplate.Execute(response, page) // nolint:errcheck
+}
+
+// wantsJSON tests if a request asked for JSON output.
+func wantsJSON(request *http.Request) bool {
+	if request.URL.Query().Get("format") == "json" {
+		return true
+	}
+
+	return strings.Contains(request.Header.Get("Accept"), "application/json")
+}
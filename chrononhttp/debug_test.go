@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chrononhttp
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/xmidt-org/chronon"
+)
+
+type DebugSuite struct {
+	suite.Suite
+
+	tc  *chronon.TrackingClock
+	mux *http.ServeMux
+}
+
+func (suite *DebugSuite) SetupTest() {
+	suite.tc = chronon.NewTrackingClock(chronon.NewFakeClock(time.Now()))
+	suite.tc.NewTicker(time.Minute)
+
+	suite.mux = http.NewServeMux()
+	RegisterDebug(suite.mux, suite.tc)
+}
+
+func (suite *DebugSuite) serve(request *http.Request) *httptest.ResponseRecorder {
+	response := httptest.NewRecorder()
+	suite.mux.ServeHTTP(response, request)
+	suite.Equal(http.StatusOK, response.Code)
+	return response
+}
+
+func (suite *DebugSuite) TestHTML() {
+	response := suite.serve(httptest.NewRequest("GET", DebugPath, nil))
+	suite.Contains(response.Header().Get("Content-Type"), "text/html")
+	suite.Contains(response.Body.String(), "debug_test.go")
+	suite.Contains(response.Body.String(), "ticker")
+}
+
+func (suite *DebugSuite) TestJSON() {
+	for name, request := range map[string]*http.Request{
+		"Query":  httptest.NewRequest("GET", DebugPath+"?format=json", nil),
+		"Accept": httptest.NewRequest("GET", DebugPath, nil),
+	} {
+		suite.Run(name, func() {
+			request.Header.Set("Accept", "application/json")
+			response := suite.serve(request)
+			suite.Contains(response.Header().Get("Content-Type"), "application/json")
+
+			var page debugPage
+			suite.Require().NoError(json.Unmarshal(response.Body.Bytes(), &page))
+			suite.Require().Len(page.Tracked, 1)
+			suite.Equal(chronon.KindTicker, page.Tracked[0].Kind)
+			suite.Equal(time.Minute, page.Tracked[0].Interval)
+		})
+	}
+}
+
+func (suite *DebugSuite) TestDefaultMux() {
+	suite.Same(http.DefaultServeMux, defaultMux)
+
+	// registering on the real default mux would fail when tests run more than once
+	mux := http.NewServeMux()
+	defaultMux = mux
+	defer func() { defaultMux = http.DefaultServeMux }()
+
+	RegisterDebug(nil, suite.tc)
+	handler, pattern := mux.Handler(httptest.NewRequest("GET", DebugPath, nil))
+	suite.Equal(DebugPath, pattern)
+	suite.NotNil(handler)
+}
+
+func TestDebug(t *testing.T) {
+	suite.Run(t, new(DebugSuite))
+}
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package chrononlinux
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/xmidt-org/chronon"
+)
+
+// Clock is a chronon.Clock whose Now method reads one of the kernel's clocks.  The kernel
+// cannot schedule timers on every clock, so timers, tickers, and sleeps delegate to
+// chronon.SystemClock().
+//
+// For Realtime and TAI, Now returns the clock's absolute time.  The other clocks have
+// no meaningful epoch, so Now returns the wall time at which the Clock was created plus
+// the amount the kernel's clock has advanced since.  For example, with Boottime, the
+// difference between two times returned by Now includes any time spent suspended.
+//
+// Times returned by Now carry no monotonic clock reading, so differences between them
+// reflect this clock alone.
+type Clock struct {
+	id     ClockID
+	system chronon.Clock
+
+	// absolute indicates that readings are measured from the Unix epoch
+	absolute bool
+
+	// start and origin anchor readings of a clock that has no meaningful epoch
+	start  time.Time
+	origin time.Duration
+}
+
+var _ chronon.Clock = (*Clock)(nil)
+
+// NewClock creates a Clock that reads the given kernel clock.  If the kernel does
+// not support that clock, an error is returned.
+func NewClock(id ClockID) (*Clock, error) {
+	c := &Clock{
+		id:       id,
+		system:   chronon.SystemClock(),
+		absolute: id == Realtime || id == TAI,
+		start:    time.Now().Round(0),
+	}
+
+	var err error
+	if c.origin, err = id.Read(); err != nil {
+		return nil, fmt.Errorf("unable to read %s: %w", id, err)
+	}
+
+	return c, nil
+}
+
+// ID returns the kernel clock that this Clock reads.
+func (c *Clock) ID() ClockID {
+	return c.id
+}
+
+func (c *Clock) Now() time.Time {
+	// NewClock verified that the kernel supports this clock, and reading a
+	// supported clock cannot fail
+	reading, _ := c.id.Read()
+	if c.absolute {
+		return time.Unix(0, int64(reading))
+	}
+
+	return c.start.Add(reading - c.origin)
+}
+
+func (c *Clock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+func (c *Clock) Until(t time.Time) time.Duration {
+	return t.Sub(c.Now())
+}
+
+func (c *Clock) Sleep(d time.Duration) {
+	c.system.Sleep(d)
+}
+
+func (c *Clock) After(d time.Duration) <-chan time.Time {
+	return c.system.After(d)
+}
+
+func (c *Clock) AfterFunc(d time.Duration, f func()) chronon.Timer {
+	return c.system.AfterFunc(d, f)
+}
+
+func (c *Clock) Tick(d time.Duration) <-chan time.Time {
+	return c.system.Tick(d)
+}
+
+func (c *Clock) NewTicker(d time.Duration) chronon.Ticker {
+	return c.system.NewTicker(d)
+}
+
+func (c *Clock) NewTimer(d time.Duration) chronon.Timer {
+	return c.system.NewTimer(d)
+}
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package chrononlinux
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/sys/unix"
+)
+
+type ClockSuite struct {
+	suite.Suite
+}
+
+func (suite *ClockSuite) TestString() {
+	suite.Equal("CLOCK_BOOTTIME", Boottime.String())
+	suite.Equal("CLOCK_TAI", TAI.String())
+	suite.Equal("ClockID(100)", ClockID(100).String())
+}
+
+func (suite *ClockSuite) TestRead() {
+	for _, id := range []ClockID{Realtime, Monotonic, ProcessCPUTime, ThreadCPUTime, MonotonicRaw, Boottime, TAI} {
+		suite.Run(id.String(), func() {
+			first, err := id.Read()
+			suite.Require().NoError(err)
+			suite.Positive(first)
+
+			elapsed, err := id.Since(first)
+			suite.Require().NoError(err)
+			suite.GreaterOrEqual(elapsed, time.Duration(0))
+		})
+	}
+
+	_, err := ClockID(100).Read()
+	suite.ErrorIs(err, unix.EINVAL)
+}
+
+func (suite *ClockSuite) TestBoottime() {
+	monotonic, err := Monotonic.Read()
+	suite.Require().NoError(err)
+	boot, err := Boottime.Read()
+	suite.Require().NoError(err)
+
+	// boot time includes everything monotonic time does
+	suite.GreaterOrEqual(boot, monotonic)
+}
+
+func (suite *ClockSuite) TestAbsolute() {
+	for _, id := range []ClockID{Realtime, TAI} {
+		c, err := NewClock(id)
+		suite.Require().NoError(err)
+		suite.Equal(id, c.ID())
+
+		// TAI leads UTC by at most the number of leap seconds
+		suite.WithinDuration(time.Now(), c.Now(), time.Minute)
+	}
+}
+
+func (suite *ClockSuite) TestRelative() {
+	for _, id := range []ClockID{Monotonic, MonotonicRaw, Boottime} {
+		suite.Run(id.String(), func() {
+			c, err := NewClock(id)
+			suite.Require().NoError(err)
+
+			start := c.Now()
+			suite.WithinDuration(time.Now(), start, time.Second)
+			suite.Equal(start.Round(0), start, "times must not carry a monotonic reading")
+
+			time.Sleep(10 * time.Millisecond)
+			suite.GreaterOrEqual(c.Since(start), 9*time.Millisecond)
+			suite.Less(c.Until(start), time.Duration(0))
+		})
+	}
+}
+
+func (suite *ClockSuite) TestInvalid() {
+	c, err := NewClock(ClockID(100))
+	suite.ErrorIs(err, unix.EINVAL)
+	suite.Nil(c)
+}
+
+func (suite *ClockSuite) TestDelegates() {
+	c, err := NewClock(Boottime)
+	suite.Require().NoError(err)
+
+	const d = time.Millisecond
+	c.Sleep(d)
+
+	wait := func(ch <-chan time.Time) {
+		select {
+		case <-ch:
+		case <-time.After(time.Second):
+			suite.Fail("no event received")
+		}
+	}
+
+	wait(c.After(d))
+	wait(c.NewTimer(d).C())
+	wait(c.Tick(d))
+
+	ticker := c.NewTicker(d)
+	wait(ticker.C())
+	ticker.Stop()
+
+	called := make(chan time.Time)
+	c.AfterFunc(d, func() { close(called) })
+	wait(called)
+}
+
+func TestClock(t *testing.T) {
+	suite.Run(t, new(ClockSuite))
+}
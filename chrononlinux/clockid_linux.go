@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package chrononlinux
+
+import (
+	"strconv"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// ClockID identifies one of the kernel's clocks.  See clock_gettime(2).
+type ClockID int32
+
+const (
+	// Realtime is CLOCK_REALTIME, the system's wall clock, which is what time.Now reads.
+	Realtime ClockID = 0
+
+	// Monotonic is CLOCK_MONOTONIC, which is what the time package uses for monotonic
+	// clock readings.  It is slewed by NTP and does not count time spent suspended.
+	Monotonic ClockID = 1
+
+	// ProcessCPUTime is CLOCK_PROCESS_CPUTIME_ID, the CPU time consumed by all
+	// threads in this process.
+	ProcessCPUTime ClockID = 2
+
+	// ThreadCPUTime is CLOCK_THREAD_CPUTIME_ID, the CPU time consumed by the calling
+	// thread.  Since goroutines move between threads, this is only meaningful for a
+	// goroutine that has called runtime.LockOSThread.
+	ThreadCPUTime ClockID = 3
+
+	// MonotonicRaw is CLOCK_MONOTONIC_RAW, a monotonic clock that is not subject to
+	// NTP adjustments.
+	MonotonicRaw ClockID = 4
+
+	// Boottime is CLOCK_BOOTTIME, which is like Monotonic except that it also counts
+	// time spent suspended.
+	Boottime ClockID = 7
+
+	// TAI is CLOCK_TAI, International Atomic Time.  It is ahead of Realtime by the number
+	// of leap seconds configured in the kernel, which is zero unless something such as
+	// an NTP daemon has set it.
+	TAI ClockID = 11
+)
+
+var clockNames = map[ClockID]string{
+	Realtime:       "CLOCK_REALTIME",
+	Monotonic:      "CLOCK_MONOTONIC",
+	ProcessCPUTime: "CLOCK_PROCESS_CPUTIME_ID",
+	ThreadCPUTime:  "CLOCK_THREAD_CPUTIME_ID",
+	MonotonicRaw:   "CLOCK_MONOTONIC_RAW",
+	Boottime:       "CLOCK_BOOTTIME",
+	TAI:            "CLOCK_TAI",
+}
+
+// String returns the kernel's name for this clock.
+func (id ClockID) String() string {
+	if name, ok := clockNames[id]; ok {
+		return name
+	}
+
+	return "ClockID(" + strconv.Itoa(int(id)) + ")"
+}
+
+// Read returns this clock's current reading, which is the time since the clock's
+// epoch.  For Realtime and TAI, the epoch is the Unix epoch.  For the other clocks,
+// the epoch is unspecified, so only differences between readings are meaningful.
+// If the kernel does not support this clock, an error is returned.
+//
+// Clocks are read through the vDSO where the kernel provides it, so most reads
+// do not make a system call.
+func (id ClockID) Read() (time.Duration, error) {
+	var ts unix.Timespec
+	if err := unix.ClockGettime(int32(id), &ts); err != nil {
+		return 0, err
+	}
+
+	return time.Duration(ts.Nano()), nil
+}
+
+// Since returns the amount of time this clock has advanced since the given reading.
+func (id ClockID) Since(start time.Duration) (time.Duration, error) {
+	now, err := id.Read()
+	return now - start, err
+}
@@ -0,0 +1,11 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+// Package chrononlinux provides chronon.Clock implementations backed by the Linux
+// clock_gettime system call, for clocks that the time package does not expose:
+// CLOCK_BOOTTIME, which keeps counting while the system is suspended, CLOCK_MONOTONIC_RAW,
+// which is not slewed by NTP, CLOCK_TAI, and the process and thread CPU time clocks.
+// It also provides stopwatches that compare CPU time with elapsed wall time.
+//
+// Everything in this package other than this documentation requires Linux.
+package chrononlinux
@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package chrononlinux
+
+import (
+	"runtime"
+	"time"
+)
+
+// Elapsed is the time measured by a Stopwatch.
+type Elapsed struct {
+	// Wall is the elapsed monotonic time, as with time.Since.
+	Wall time.Duration
+
+	// Boot is the elapsed time including any time spent suspended.
+	Boot time.Duration
+
+	// ProcessCPU is the CPU time consumed by all threads in this process.
+	ProcessCPU time.Duration
+
+	// ThreadCPU is the CPU time consumed by the thread that started the Stopwatch.  This
+	// is zero unless the Stopwatch was created with StartThreadStopwatch.
+	ThreadCPU time.Duration
+}
+
+// Utilization is the average number of CPUs this process used while the Stopwatch ran.
+// For example, a value of 1.5 means that the process kept one and a half CPUs busy.
+// If no wall time elapsed, this method returns zero.
+func (e Elapsed) Utilization() float64 {
+	if e.Wall <= 0 {
+		return 0
+	}
+
+	return float64(e.ProcessCPU) / float64(e.Wall)
+}
+
+// Stopwatch measures elapsed wall time alongside CPU time.  This distinguishes code
+// that is slow because it computes from code that is slow because it waits.
+type Stopwatch struct {
+	wall       time.Time
+	boot       time.Duration
+	processCPU time.Duration
+	threadCPU  time.Duration
+	thread     bool
+}
+
+// StartStopwatch starts a Stopwatch that measures wall, boot, and process CPU time.
+func StartStopwatch() (*Stopwatch, error) {
+	sw := &Stopwatch{
+		wall: time.Now(),
+	}
+
+	var err error
+	if sw.boot, err = Boottime.Read(); err == nil {
+		sw.processCPU, err = ProcessCPUTime.Read()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return sw, nil
+}
+
+// StartThreadStopwatch is like StartStopwatch, except that the Stopwatch also measures the
+// CPU time of the calling goroutine's thread.  The calling goroutine is locked to its thread
+// with runtime.LockOSThread until Stop is called, so Stop must be called from the same goroutine.
+func StartThreadStopwatch() (*Stopwatch, error) {
+	runtime.LockOSThread()
+	sw, err := StartStopwatch()
+	if err == nil {
+		sw.thread = true
+		sw.threadCPU, err = ThreadCPUTime.Read()
+	}
+
+	if err != nil {
+		runtime.UnlockOSThread()
+		return nil, err
+	}
+
+	return sw, nil
+}
+
+// Elapsed returns the time measured since this Stopwatch started.  The Stopwatch
+// continues to run.  For a Stopwatch created with StartThreadStopwatch, this method
+// must be called from the goroutine that started it.
+func (sw *Stopwatch) Elapsed() (e Elapsed, err error) {
+	e.Wall = time.Since(sw.wall)
+	if e.Boot, err = Boottime.Since(sw.boot); err != nil {
+		return
+	}
+
+	if e.ProcessCPU, err = ProcessCPUTime.Since(sw.processCPU); err != nil {
+		return
+	}
+
+	if sw.thread {
+		e.ThreadCPU, err = ThreadCPUTime.Since(sw.threadCPU)
+	}
+
+	return
+}
+
+// Stop returns the time measured since this Stopwatch started.  If this Stopwatch was
+// created with StartThreadStopwatch, the calling goroutine is unlocked from its thread.
+// Stop must be called only once.
+func (sw *Stopwatch) Stop() (Elapsed, error) {
+	e, err := sw.Elapsed()
+	if sw.thread {
+		runtime.UnlockOSThread()
+	}
+
+	return e, err
+}
+
+// Measure runs f and returns the time it took.
+func Measure(f func()) (Elapsed, error) {
+	sw, err := StartStopwatch()
+	if err != nil {
+		return Elapsed{}, err
+	}
+
+	f()
+	return sw.Stop()
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package chrononlinux
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type StopwatchSuite struct {
+	suite.Suite
+}
+
+// spin keeps the CPU busy until the calling thread has consumed the given
+// CPU time.  Measuring CPU time rather than wall time keeps this reliable when
+// other processes compete for the CPU.
+func spin(d time.Duration) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	start, err := ThreadCPUTime.Read()
+	for elapsed := time.Duration(0); err == nil && elapsed < d; {
+		elapsed, err = ThreadCPUTime.Since(start)
+	}
+}
+
+func (suite *StopwatchSuite) TestStopwatch() {
+	sw, err := StartStopwatch()
+	suite.Require().NoError(err)
+
+	time.Sleep(20 * time.Millisecond)
+	e, err := sw.Elapsed()
+	suite.Require().NoError(err)
+	suite.GreaterOrEqual(e.Wall, 20*time.Millisecond)
+	suite.GreaterOrEqual(e.Boot, 19*time.Millisecond)
+	suite.Zero(e.ThreadCPU)
+
+	// waiting uses far less CPU than the wall time that elapsed
+	suite.Less(e.ProcessCPU, e.Wall)
+
+	e, err = sw.Stop()
+	suite.Require().NoError(err)
+	suite.GreaterOrEqual(e.Wall, 20*time.Millisecond)
+}
+
+func (suite *StopwatchSuite) TestThreadStopwatch() {
+	sw, err := StartThreadStopwatch()
+	suite.Require().NoError(err)
+
+	spin(20 * time.Millisecond)
+	e, err := sw.Stop()
+	suite.Require().NoError(err)
+	suite.GreaterOrEqual(e.ThreadCPU, 20*time.Millisecond)
+	suite.GreaterOrEqual(e.ProcessCPU, e.ThreadCPU)
+	suite.Positive(e.Utilization())
+}
+
+func (suite *StopwatchSuite) TestMeasure() {
+	e, err := Measure(func() { spin(20 * time.Millisecond) })
+	suite.Require().NoError(err)
+	suite.GreaterOrEqual(e.Wall, 20*time.Millisecond)
+	suite.GreaterOrEqual(e.ProcessCPU, 20*time.Millisecond)
+}
+
+func (suite *StopwatchSuite) TestUtilization() {
+	suite.Zero(Elapsed{}.Utilization())
+	suite.Equal(1.5, Elapsed{Wall: time.Second, ProcessCPU: 1500 * time.Millisecond}.Utilization())
+}
+
+func TestStopwatch(t *testing.T) {
+	suite.Run(t, new(StopwatchSuite))
+}
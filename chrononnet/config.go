@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+// Package chrononnet provides simulated network connections whose timing is
+// driven by a chronon.Clock.  With a *chronon.FakeClock, tests of network
+// timeouts, latency, and loss run instantly and reproducibly.
+package chrononnet
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Config describes the characteristics of a simulated network link.  The zero
+// value is a perfect link that delivers everything instantly.
+type Config struct {
+	// Latency is the fixed delay applied to every message.
+	Latency time.Duration
+
+	// Jitter is the maximum random delay added to Latency.  Each message is delayed
+	// by an additional amount chosen uniformly from [0, Jitter).  Since each message
+	// is delayed independently, jitter can cause messages on a Link to arrive out of
+	// order.  Connections never reorder data, so their jitter only delays it.
+	Jitter time.Duration
+
+	// Bandwidth is the number of bytes per second that a link can transmit.  Messages
+	// are transmitted one at a time, so a large message delays the ones sent after it.
+	// If this field is nonpositive, bandwidth is unlimited.
+	Bandwidth int64
+
+	// Loss is the probability, from 0.0 to 1.0, that a message is silently dropped.
+	Loss float64
+
+	// Seed is the seed for the random source used for jitter and loss.  Links
+	// with the same Seed and Config make the same choices, which makes tests
+	// reproducible.
+	Seed int64
+}
+
+// newRand creates the random source for a link.
+func (cfg Config) newRand() *rand.Rand {
+	return rand.New(rand.NewSource(cfg.Seed)) // nolint:gosec
+}
+
+// transmitTime returns the time it takes to put a message of the given size onto the link.
+func (cfg Config) transmitTime(size int) time.Duration {
+	if cfg.Bandwidth <= 0 || size <= 0 {
+		return 0
+	}
+
+	return time.Duration(int64(size) * int64(time.Second) / cfg.Bandwidth)
+}
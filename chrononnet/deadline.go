@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chrononnet
+
+import (
+	"sync"
+	"time"
+
+	"github.com/xmidt-org/chronon"
+)
+
+// deadline is a read or write deadline enforced by a chronon.Clock.  Blocked
+// operations select on the channel returned by wait, which is closed when the
+// deadline is exceeded.
+type deadline struct {
+	clock chronon.Clock
+
+	lock     sync.Mutex
+	timer    chronon.Timer
+	exceeded chan struct{}
+}
+
+func newDeadline(clock chronon.Clock) *deadline {
+	return &deadline{
+		clock:    clock,
+		exceeded: make(chan struct{}),
+	}
+}
+
+// set establishes a new deadline.  The zero time clears the deadline.  Operations
+// that are already blocked observe the change, so a deadline can be extended or
+// cleared while a Read is in progress.
+func (d *deadline) set(t time.Time) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.timer != nil && !d.timer.Stop() {
+		// The timer's function closes the channel it captured, possibly after this
+		// method returns, so that channel is abandoned.  Waiting for the function
+		// could deadlock, since a FakeClock may invoke it on this goroutine.
+		d.exceeded = make(chan struct{})
+	}
+
+	d.timer = nil
+	closed := isClosed(d.exceeded)
+	if t.IsZero() {
+		if closed {
+			d.exceeded = make(chan struct{})
+		}
+
+		return
+	}
+
+	if dur := d.clock.Until(t); dur > 0 {
+		if closed {
+			d.exceeded = make(chan struct{})
+		}
+
+		exceeded := d.exceeded
+		d.timer = d.clock.AfterFunc(dur, func() {
+			close(exceeded)
+		})
+
+		return
+	}
+
+	// the deadline is in the past
+	if !closed {
+		close(d.exceeded)
+	}
+}
+
+// wait returns a channel that is closed when this deadline is exceeded.
+func (d *deadline) wait() <-chan struct{} {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.exceeded
+}
+
+// isClosed tests if the given channel is closed without blocking.
+func isClosed(ch <-chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chrononnet
+
+import (
+	"errors"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/xmidt-org/chronon"
+)
+
+type DeadlineSuite struct {
+	suite.Suite
+
+	fc   *chronon.FakeClock
+	a, b net.Conn
+}
+
+func (suite *DeadlineSuite) SetupTest() {
+	suite.fc = chronon.NewFakeClock(time.Now())
+	suite.a, suite.b = Pipe(suite.fc, Config{})
+}
+
+func (suite *DeadlineSuite) TearDownTest() {
+	suite.a.Close()
+	suite.b.Close()
+}
+
+// read starts a Read on the b end in a separate goroutine.
+func (suite *DeadlineSuite) read() <-chan error {
+	result := make(chan error, 1)
+	go func() {
+		_, err := suite.b.Read(make([]byte, 100))
+		result <- err
+	}()
+
+	return result
+}
+
+func (suite *DeadlineSuite) requireBlocked(result <-chan error) {
+	select {
+	case err := <-result:
+		suite.Require().Failf("the read should have been blocked", "error: %v", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+func (suite *DeadlineSuite) requireResult(result <-chan error) error {
+	select {
+	case err := <-result:
+		return err
+	case <-time.After(WaitALittle):
+		suite.Require().Fail("the read did not complete")
+		return nil
+	}
+}
+
+func (suite *DeadlineSuite) requireTimeout(err error) {
+	suite.Require().Error(err)
+	suite.ErrorIs(err, os.ErrDeadlineExceeded)
+
+	var ne net.Error
+	suite.Require().True(errors.As(err, &ne))
+	suite.True(ne.Timeout())
+}
+
+func (suite *DeadlineSuite) TestReadDeadline() {
+	suite.NoError(suite.b.SetReadDeadline(suite.fc.Now().Add(time.Second)))
+	result := suite.read()
+	suite.requireBlocked(result)
+
+	suite.fc.Add(time.Second)
+	suite.requireTimeout(suite.requireResult(result))
+
+	// subsequent reads fail immediately
+	suite.requireTimeout(suite.requireResult(suite.read()))
+
+	// clearing an exceeded deadline allows reads again
+	suite.NoError(suite.b.SetReadDeadline(time.Time{}))
+	result = suite.read()
+	suite.requireBlocked(result)
+
+	_, err := suite.a.Write([]byte("hello"))
+	suite.NoError(err)
+	suite.NoError(suite.requireResult(result))
+}
+
+func (suite *DeadlineSuite) TestExtendDuringRead() {
+	suite.NoError(suite.b.SetReadDeadline(suite.fc.Now().Add(time.Second)))
+	result := suite.read()
+	suite.requireBlocked(result)
+
+	suite.NoError(suite.b.SetReadDeadline(suite.fc.Now().Add(2 * time.Second)))
+	suite.fc.Add(time.Second)
+	suite.requireBlocked(result)
+
+	suite.fc.Add(time.Second)
+	suite.requireTimeout(suite.requireResult(result))
+}
+
+func (suite *DeadlineSuite) TestExtendWhenExceeded() {
+	for i := 0; i < 20; i++ {
+		deadline := suite.fc.Now().Add(time.Second)
+		suite.NoError(suite.b.SetReadDeadline(deadline))
+
+		// extend the deadline from a function due at the same instant
+		suite.fc.AfterFunc(time.Second, func() {
+			suite.NoError(suite.b.SetReadDeadline(deadline.Add(time.Second)))
+		})
+
+		added := make(chan struct{})
+		go func() {
+			defer close(added)
+			suite.fc.Add(time.Second)
+		}()
+
+		select {
+		case <-added:
+		case <-time.After(WaitALittle):
+			suite.Require().Fail("extending an exceeded deadline deadlocked")
+		}
+
+		result := suite.read()
+		suite.requireBlocked(result)
+		suite.fc.Add(time.Second)
+		suite.requireTimeout(suite.requireResult(result))
+	}
+}
+
+func (suite *DeadlineSuite) TestClearDuringRead() {
+	suite.NoError(suite.b.SetDeadline(suite.fc.Now().Add(time.Second)))
+	result := suite.read()
+	suite.requireBlocked(result)
+
+	suite.NoError(suite.b.SetDeadline(time.Time{}))
+	suite.fc.Add(time.Hour)
+	suite.requireBlocked(result)
+
+	_, err := suite.a.Write([]byte("hello"))
+	suite.NoError(err)
+	suite.NoError(suite.requireResult(result))
+}
+
+func (suite *DeadlineSuite) TestPastDeadline() {
+	suite.NoError(suite.b.SetReadDeadline(suite.fc.Now().Add(-time.Second)))
+	suite.requireTimeout(suite.requireResult(suite.read()))
+}
+
+func (suite *DeadlineSuite) TestWriteDeadline() {
+	suite.NoError(suite.a.SetWriteDeadline(suite.fc.Now().Add(time.Second)))
+	_, err := suite.a.Write([]byte("before"))
+	suite.NoError(err)
+
+	suite.fc.Add(time.Second)
+	_, err = suite.a.Write([]byte("after"))
+	suite.requireTimeout(err)
+}
+
+func TestDeadline(t *testing.T) {
+	suite.Run(t, new(DeadlineSuite))
+}
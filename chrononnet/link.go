@@ -0,0 +1,224 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chrononnet
+
+import (
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/xmidt-org/chronon"
+)
+
+// Link is a simulated, one-way network link that carries messages of type T.
+// Each message is delivered to C() once the link's Clock reaches that message's
+// delivery time, as determined by the link's Config.
+//
+// When a *chronon.FakeClock is used, messages are only delivered as test code
+// advances the clock.  Network timeouts can then be tested instantly and reproducibly.
+// A two-way link is simply a pair of Links.
+type Link[T any] struct {
+	clock chronon.Clock
+	cfg   Config
+	size  func(T) int
+
+	// ordered links never deliver a message before one that was sent earlier
+	ordered bool
+
+	lock     sync.Mutex
+	random   *rand.Rand
+	busy     time.Time // the time at which the link finishes its current transmission
+	last     time.Time // the latest delivery time scheduled on an ordered link
+	inFlight int
+	queue    []T // the messages in flight on an ordered link, in the order they were sent
+	ready    []T
+	closed   bool
+
+	signal chan struct{}
+	halt   chan struct{}
+	once   sync.Once
+	c      chan T
+}
+
+// NewLink creates a Link that schedules deliveries through the given Clock.  The size
+// function reports the number of bytes in each message for the purposes of Config.Bandwidth.
+// If size is nil, each message counts as a single byte.
+func NewLink[T any](clock chronon.Clock, cfg Config, size func(T) int) *Link[T] {
+	return newLink(clock, cfg, size, false)
+}
+
+// newLink is the implementation of NewLink.  An ordered link delays each message
+// until at least the delivery time of the message sent before it, which preserves
+// the byte stream of a connection even when there is jitter.
+func newLink[T any](clock chronon.Clock, cfg Config, size func(T) int, ordered bool) *Link[T] {
+	if size == nil {
+		size = func(T) int { return 1 }
+	}
+
+	l := &Link[T]{
+		clock:   clock,
+		cfg:     cfg,
+		size:    size,
+		ordered: ordered,
+		random:  cfg.newRand(),
+		signal:  make(chan struct{}, 1),
+		halt:    make(chan struct{}),
+		c:       make(chan T),
+	}
+
+	go l.pump()
+	return l
+}
+
+// C returns the channel on which this Link delivers messages.  This channel is
+// closed after Close is called and all messages in flight have been delivered.
+func (l *Link[T]) C() <-chan T {
+	return l.c
+}
+
+// Send puts a message onto this Link.  The message may be dropped according
+// to Config.Loss, in which case it is never delivered.  If this Link has been
+// closed, this method returns net.ErrClosed.
+func (l *Link[T]) Send(v T) error {
+	delay, ok, err := l.schedule(v)
+	if ok {
+		// the lock must not be held here, since a clock may invoke the function immediately
+		l.clock.AfterFunc(delay, func() { l.deliver(v) })
+	}
+
+	return err
+}
+
+// schedule computes the delay for the given message.  If the message is dropped
+// or this Link is closed, this method returns false.
+func (l *Link[T]) schedule(v T) (delay time.Duration, ok bool, err error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.closed {
+		err = net.ErrClosed
+		return
+	}
+
+	now := l.clock.Now()
+	if l.busy.Before(now) {
+		l.busy = now
+	}
+
+	l.busy = l.busy.Add(l.cfg.transmitTime(l.size(v)))
+	if l.cfg.Loss > 0 && l.random.Float64() < l.cfg.Loss {
+		// the message still used up bandwidth
+		return
+	}
+
+	delay = l.busy.Sub(now) + l.cfg.Latency
+	if l.cfg.Jitter > 0 {
+		delay += time.Duration(l.random.Int63n(int64(l.cfg.Jitter)))
+	}
+
+	if l.ordered {
+		if at := now.Add(delay); at.Before(l.last) {
+			delay = l.last.Sub(now)
+		} else {
+			l.last = at
+		}
+
+		l.queue = append(l.queue, v)
+	}
+
+	l.inFlight++
+	ok = true
+	return
+}
+
+// Close stops this Link from accepting new messages.  Messages already in flight
+// are still delivered, after which the C() channel is closed.  This method is idempotent.
+func (l *Link[T]) Close() error {
+	l.lock.Lock()
+	l.closed = true
+	l.lock.Unlock()
+
+	l.notify()
+	return nil
+}
+
+// stop closes this Link and abandons any messages that have not yet been
+// received.  This is used when nothing will ever read from the C() channel again.
+func (l *Link[T]) stop() {
+	l.Close()
+	l.once.Do(func() {
+		close(l.halt)
+	})
+}
+
+// deliver moves a message that has arrived into the queue of messages
+// waiting to be received.
+func (l *Link[T]) deliver(v T) {
+	l.lock.Lock()
+	if l.ordered {
+		// timers due at the same time can fire in any order, but the oldest
+		// message in flight is always due by the time any later one is
+		v, l.queue = l.queue[0], l.queue[1:]
+	}
+
+	l.inFlight--
+	l.ready = append(l.ready, v)
+	l.lock.Unlock()
+
+	l.notify()
+}
+
+// notify wakes up the pump goroutine.
+func (l *Link[T]) notify() {
+	select {
+	case l.signal <- struct{}{}:
+	default:
+	}
+}
+
+// next returns the next message to deliver, if any, and whether the pump
+// goroutine should exit.
+func (l *Link[T]) next() (v T, ok, done bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	switch {
+	case len(l.ready) > 0:
+		v, ok = l.ready[0], true
+		l.ready = l.ready[1:]
+
+	case l.closed && l.inFlight == 0:
+		done = true
+	}
+
+	return
+}
+
+// pump is the goroutine that sends arrived messages on the C() channel in the
+// order in which they arrived.  This keeps slow receivers from blocking the Clock.
+func (l *Link[T]) pump() {
+	defer close(l.c)
+	for {
+		v, ok, done := l.next()
+		switch {
+		case done:
+			return
+
+		case ok:
+			select {
+			case l.c <- v:
+			case <-l.halt:
+				return
+			}
+
+		default:
+			select {
+			case <-l.signal:
+			case <-l.halt:
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chrononnet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/xmidt-org/chronon"
+)
+
+// WaitALittle is how long tests wait for a concurrent event.
+const WaitALittle = 100 * time.Millisecond
+
+type LinkSuite struct {
+	suite.Suite
+
+	now time.Time
+}
+
+func (suite *LinkSuite) SetupTest() {
+	suite.now = time.Now()
+}
+
+func (suite *LinkSuite) newLink(cfg Config) (*Link[string], *chronon.FakeClock) {
+	fc := chronon.NewFakeClock(suite.now)
+	l := NewLink(fc, cfg, func(s string) int { return len(s) })
+	suite.Require().NotNil(l)
+	suite.Require().NotNil(l.C())
+	return l, fc
+}
+
+func (suite *LinkSuite) requireReceive(l *Link[string], expected string) {
+	select {
+	case actual := <-l.C():
+		suite.Equal(expected, actual)
+	case <-time.After(WaitALittle):
+		suite.Require().Failf("no message received", "expected: %s", expected)
+	}
+}
+
+func (suite *LinkSuite) requireNoReceive(l *Link[string]) {
+	select {
+	case actual := <-l.C():
+		suite.Require().Failf("unexpected message", "received: %s", actual)
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+func (suite *LinkSuite) TestPerfect() {
+	l, _ := suite.newLink(Config{})
+	suite.NoError(l.Send("hello"))
+	suite.requireReceive(l, "hello")
+}
+
+func (suite *LinkSuite) TestLatency() {
+	l, fc := suite.newLink(Config{Latency: time.Second})
+	suite.NoError(l.Send("hello"))
+	suite.requireNoReceive(l)
+
+	fc.Add(time.Second - 1)
+	suite.requireNoReceive(l)
+
+	fc.Add(1)
+	suite.requireReceive(l, "hello")
+}
+
+func (suite *LinkSuite) TestJitter() {
+	cfg := Config{
+		Latency: time.Second,
+		Jitter:  time.Second,
+		Seed:    1234,
+	}
+
+	// links with the same seed make the same choices
+	delays := func() (d []time.Duration) {
+		l, _ := suite.newLink(cfg)
+		for _, m := range []string{"a", "b", "c", "d", "e"} {
+			delay, ok, err := l.schedule(m)
+			suite.Require().True(ok)
+			suite.Require().NoError(err)
+			suite.GreaterOrEqual(delay, cfg.Latency)
+			suite.Less(delay, cfg.Latency+cfg.Jitter)
+			d = append(d, delay)
+		}
+
+		return
+	}
+
+	first := delays()
+	suite.Equal(first, delays())
+}
+
+func (suite *LinkSuite) TestBandwidth() {
+	l, fc := suite.newLink(Config{Bandwidth: 10})
+	suite.NoError(l.Send("0123456789"))
+	suite.NoError(l.Send("01234"))
+
+	fc.Add(time.Second)
+	suite.requireReceive(l, "0123456789")
+	suite.requireNoReceive(l)
+
+	fc.Add(500 * time.Millisecond)
+	suite.requireReceive(l, "01234")
+}
+
+func (suite *LinkSuite) TestLoss() {
+	l, fc := suite.newLink(Config{Loss: 1.0})
+	suite.NoError(l.Send("dropped"))
+	fc.Add(time.Hour)
+	suite.requireNoReceive(l)
+}
+
+func (suite *LinkSuite) TestClose() {
+	l, fc := suite.newLink(Config{Latency: time.Second})
+	suite.NoError(l.Send("in flight"))
+	suite.NoError(l.Close())
+	suite.NoError(l.Close())
+	suite.Error(l.Send("rejected"))
+
+	fc.Add(time.Second)
+	suite.requireReceive(l, "in flight")
+
+	select {
+	case _, ok := <-l.C():
+		suite.False(ok)
+	case <-time.After(WaitALittle):
+		suite.Fail("the link channel was not closed")
+	}
+}
+
+func (suite *LinkSuite) TestSizeDefault() {
+	fc := chronon.NewFakeClock(suite.now)
+	l := NewLink[int](fc, Config{Bandwidth: 2}, nil)
+	suite.NoError(l.Send(1))
+	fc.Add(time.Second / 2)
+
+	select {
+	case v := <-l.C():
+		suite.Equal(1, v)
+	case <-time.After(WaitALittle):
+		suite.Fail("no message received")
+	}
+}
+
+func TestLink(t *testing.T) {
+	suite.Run(t, new(LinkSuite))
+}
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chrononnet
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"github.com/xmidt-org/chronon"
+)
+
+// Listener is an in-memory net.Listener.  Connections are established with
+// Dial or DialContext, and each one is a simulated connection created as with Pipe.
+// Deadlines on both ends of each connection are enforced by the Listener's Clock.
+type Listener struct {
+	clock chronon.Clock
+	cfg   Config
+	addr  addr
+
+	conns chan net.Conn
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+var _ net.Listener = (*Listener)(nil)
+
+// Listen creates a Listener with the given address.  Each connection to the
+// returned Listener uses the given Clock and Config.
+func Listen(clock chronon.Clock, cfg Config, address string) *Listener {
+	return &Listener{
+		clock:  clock,
+		cfg:    cfg,
+		addr:   addr(address),
+		conns:  make(chan net.Conn),
+		closed: make(chan struct{}),
+	}
+}
+
+// Accept waits for and returns the next connection to this Listener.  If this
+// Listener is closed, this method returns net.ErrClosed.
+func (l *Listener) Accept() (net.Conn, error) {
+	select {
+	case c := <-l.conns:
+		return c, nil
+	case <-l.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+// Close closes this Listener.  Blocked Accept and Dial calls return errors.
+func (l *Listener) Close() error {
+	l.closeOnce.Do(func() {
+		close(l.closed)
+	})
+
+	return nil
+}
+
+// Addr returns this Listener's network address.
+func (l *Listener) Addr() net.Addr {
+	return l.addr
+}
+
+// Dial connects to this Listener.  This method blocks until the connection is
+// accepted or this Listener is closed.
+func (l *Listener) Dial() (net.Conn, error) {
+	return l.DialContext(context.Background(), l.addr.Network(), l.addr.String())
+}
+
+// DialContext connects to this Listener, blocking until the connection is accepted,
+// this Listener is closed, or the context is done.  The network and address are
+// ignored.  This method can be used as the DialContext for an http.Transport.
+func (l *Listener) DialContext(ctx context.Context, _, _ string) (net.Conn, error) {
+	clientToServer := newLink(l.clock, l.cfg, bytesSize, true)
+
+	cfg := l.cfg
+	cfg.Seed++
+	serverToClient := newLink(l.clock, cfg, bytesSize, true)
+
+	var (
+		client = newConn(serverToClient, clientToServer, addr("client"), l.addr)
+		server = newConn(clientToServer, serverToClient, l.addr, addr("client"))
+	)
+
+	select {
+	case l.conns <- server:
+		return client, nil
+
+	case <-l.closed:
+		client.Close()
+		server.Close()
+		return nil, &net.OpError{Op: "dial", Net: l.addr.Network(), Addr: l.addr, Err: net.ErrClosed}
+
+	case <-ctx.Done():
+		client.Close()
+		server.Close()
+		return nil, ctx.Err()
+	}
+}
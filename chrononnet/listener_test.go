@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chrononnet
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/xmidt-org/chronon"
+)
+
+type ListenerSuite struct {
+	suite.Suite
+}
+
+func (suite *ListenerSuite) newListener() *Listener {
+	l := Listen(chronon.NewFakeClock(time.Now()), Config{}, "test")
+	suite.Require().NotNil(l)
+	suite.Equal("chrononnet", l.Addr().Network())
+	suite.Equal("test", l.Addr().String())
+	return l
+}
+
+func (suite *ListenerSuite) TestAcceptDial() {
+	l := suite.newListener()
+	defer l.Close()
+
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		c, err := l.Accept()
+		suite.NoError(err)
+		accepted <- c
+	}()
+
+	client, err := l.Dial()
+	suite.Require().NoError(err)
+	defer client.Close()
+	suite.Equal("test", client.RemoteAddr().String())
+
+	var server net.Conn
+	select {
+	case server = <-accepted:
+	case <-time.After(WaitALittle):
+		suite.Require().Fail("no connection was accepted")
+	}
+
+	defer server.Close()
+	suite.Equal("test", server.LocalAddr().String())
+
+	_, err = client.Write([]byte("ping"))
+	suite.NoError(err)
+
+	b := make([]byte, 10)
+	n, err := server.Read(b)
+	suite.NoError(err)
+	suite.Equal("ping", string(b[:n]))
+}
+
+func (suite *ListenerSuite) TestClose() {
+	l := suite.newListener()
+	suite.NoError(l.Close())
+	suite.NoError(l.Close())
+
+	_, err := l.Accept()
+	suite.ErrorIs(err, net.ErrClosed)
+
+	_, err = l.Dial()
+	suite.ErrorIs(err, net.ErrClosed)
+}
+
+func (suite *ListenerSuite) TestDialContextCanceled() {
+	l := suite.newListener()
+	defer l.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := l.DialContext(ctx, "chrononnet", "test")
+	suite.True(errors.Is(err, context.Canceled))
+}
+
+func TestListener(t *testing.T) {
+	suite.Run(t, new(ListenerSuite))
+}
@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chrononnet
+
+import (
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/xmidt-org/chronon"
+)
+
+// addr is a named net.Addr for simulated connections.
+type addr string
+
+func (a addr) Network() string { return "chrononnet" }
+func (a addr) String() string  { return string(a) }
+
+// pipeAddr is the net.Addr for both ends of a Pipe.
+const pipeAddr addr = "pipe"
+
+// conn is a net.Conn that reads from one Link and writes to another.  Its
+// deadlines are enforced by the same Clock that drives its Links.
+type conn struct {
+	in, out *Link[[]byte]
+
+	local, remote net.Addr
+
+	readDeadline  *deadline
+	writeDeadline *deadline
+
+	readLock sync.Mutex
+	pending  []byte // the unread portion of the last message received
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+func newConn(in, out *Link[[]byte], local, remote net.Addr) *conn {
+	return &conn{
+		in:            in,
+		out:           out,
+		local:         local,
+		remote:        remote,
+		readDeadline:  newDeadline(in.clock),
+		writeDeadline: newDeadline(out.clock),
+		closed:        make(chan struct{}),
+	}
+}
+
+// Pipe creates a simulated, full duplex network connection.  Like net.Pipe, each
+// end's writes are read by the other end.  Unlike net.Pipe, data travels over a
+// pair of Links, so each write is delayed or dropped according to the given Config.
+// Each direction uses its own random source, seeded from Config.Seed.
+//
+// Each Write is sent as a single message, so Config.Loss drops the data from entire
+// writes.  Config.Jitter never reorders writes, since a write is not delivered before
+// the ones that preceded it.  Writes do not block waiting for the other end to read.
+//
+// Read and write deadlines are enforced by the given Clock.  An operation that
+// exceeds its deadline returns os.ErrDeadlineExceeded.
+func Pipe(clock chronon.Clock, cfg Config) (net.Conn, net.Conn) {
+	ab := newLink(clock, cfg, bytesSize, true)
+
+	cfg.Seed++
+	ba := newLink(clock, cfg, bytesSize, true)
+
+	return newConn(ba, ab, pipeAddr, pipeAddr),
+		newConn(ab, ba, pipeAddr, pipeAddr)
+}
+
+func bytesSize(b []byte) int {
+	return len(b)
+}
+
+// Read reads data sent by the other end.  Once the other end is closed and all
+// of its data has been read, this method returns io.EOF.
+func (c *conn) Read(b []byte) (int, error) {
+	c.readLock.Lock()
+	defer c.readLock.Unlock()
+
+	switch {
+	case isClosed(c.closed):
+		return 0, io.ErrClosedPipe
+
+	case isClosed(c.readDeadline.wait()):
+		return 0, os.ErrDeadlineExceeded
+	}
+
+	if len(c.pending) == 0 {
+		select {
+		case <-c.closed:
+			return 0, io.ErrClosedPipe
+
+		case <-c.readDeadline.wait():
+			return 0, os.ErrDeadlineExceeded
+
+		case p, ok := <-c.in.C():
+			if !ok {
+				return 0, io.EOF
+			}
+
+			c.pending = p
+		}
+	}
+
+	n := copy(b, c.pending)
+	c.pending = c.pending[n:]
+	return n, nil
+}
+
+// Write sends a copy of b to the other end.
+func (c *conn) Write(b []byte) (int, error) {
+	switch {
+	case isClosed(c.closed):
+		return 0, io.ErrClosedPipe
+
+	case isClosed(c.writeDeadline.wait()):
+		return 0, os.ErrDeadlineExceeded
+	}
+
+	if err := c.out.Send(append([]byte(nil), b...)); err != nil {
+		return 0, io.ErrClosedPipe
+	}
+
+	return len(b), nil
+}
+
+// Close closes this end of the connection.  Data that is already in flight is
+// still delivered to the other end, which then reads io.EOF.
+func (c *conn) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+		c.out.Close()
+		c.in.stop()
+	})
+
+	return nil
+}
+
+func (c *conn) LocalAddr() net.Addr {
+	return c.local
+}
+
+func (c *conn) RemoteAddr() net.Addr {
+	return c.remote
+}
+
+func (c *conn) SetDeadline(t time.Time) error {
+	c.readDeadline.set(t)
+	c.writeDeadline.set(t)
+	return nil
+}
+
+func (c *conn) SetReadDeadline(t time.Time) error {
+	c.readDeadline.set(t)
+	return nil
+}
+
+func (c *conn) SetWriteDeadline(t time.Time) error {
+	c.writeDeadline.set(t)
+	return nil
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chrononnet
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/xmidt-org/chronon"
+)
+
+type PipeSuite struct {
+	suite.Suite
+}
+
+// read performs a Read in a separate goroutine.
+func (suite *PipeSuite) read(c net.Conn, size int) <-chan string {
+	result := make(chan string, 1)
+	go func() {
+		b := make([]byte, size)
+		n, err := c.Read(b)
+		if err != nil {
+			result <- err.Error()
+		} else {
+			result <- string(b[:n])
+		}
+	}()
+
+	return result
+}
+
+func (suite *PipeSuite) requireResult(result <-chan string, expected string) {
+	select {
+	case actual := <-result:
+		suite.Equal(expected, actual)
+	case <-time.After(WaitALittle):
+		suite.Require().Failf("read did not complete", "expected: %s", expected)
+	}
+}
+
+func (suite *PipeSuite) TestReadWrite() {
+	fc := chronon.NewFakeClock(time.Now())
+	a, b := Pipe(fc, Config{Latency: time.Second})
+	suite.Equal("chrononnet", a.LocalAddr().Network())
+	suite.Equal("pipe", b.RemoteAddr().String())
+
+	n, err := a.Write([]byte("hello, world"))
+	suite.NoError(err)
+	suite.Equal(12, n)
+
+	result := suite.read(b, 5)
+	select {
+	case <-result:
+		suite.Fail("the read should have blocked")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	fc.Add(time.Second)
+	suite.requireResult(result, "hello")
+	suite.requireResult(suite.read(b, 100), ", world")
+
+	_, err = b.Write([]byte("reply"))
+	suite.NoError(err)
+	fc.Add(time.Second)
+	suite.requireResult(suite.read(a, 100), "reply")
+}
+
+func (suite *PipeSuite) TestJitter() {
+	fc := chronon.NewFakeClock(time.Now())
+	a, b := Pipe(fc, Config{Latency: 10 * time.Millisecond, Jitter: 50 * time.Millisecond, Seed: 1})
+
+	for _, s := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		_, err := a.Write([]byte(s))
+		suite.NoError(err)
+	}
+
+	// jitter delays writes, but never reorders them
+	fc.Add(time.Second)
+	var actual string
+	for len(actual) < 8 {
+		select {
+		case s := <-suite.read(b, 100):
+			actual += s
+		case <-time.After(WaitALittle):
+			suite.Require().Failf("read did not complete", "read so far: %s", actual)
+		}
+	}
+
+	suite.Equal("abcdefgh", actual)
+}
+
+func (suite *PipeSuite) TestClose() {
+	fc := chronon.NewFakeClock(time.Now())
+	a, b := Pipe(fc, Config{Latency: time.Second})
+
+	_, err := a.Write([]byte("last words"))
+	suite.NoError(err)
+	suite.NoError(a.Close())
+	suite.NoError(a.Close())
+
+	_, err = a.Write([]byte("too late"))
+	suite.ErrorIs(err, io.ErrClosedPipe)
+	_, err = a.Read(make([]byte, 10))
+	suite.ErrorIs(err, io.ErrClosedPipe)
+
+	fc.Add(time.Second)
+	suite.requireResult(suite.read(b, 100), "last words")
+	suite.requireResult(suite.read(b, 100), io.EOF.Error())
+
+	suite.NoError(b.Close())
+	suite.requireResult(suite.read(b, 100), io.ErrClosedPipe.Error())
+}
+
+func TestPipe(t *testing.T) {
+	suite.Run(t, new(PipeSuite))
+}
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chrononremote
+
+import (
+	"errors"
+	"strings"
+)
+
+// AddrEnv is the environment variable that holds the control address.  When set,
+// ClockFromEnv serves a fake clock at that address, and NewClientFromEnv connects to it.
+const AddrEnv = "CHRONON_CONTROL_ADDR"
+
+// unixPrefix marks an address as the path of a Unix socket.
+const unixPrefix = "unix:"
+
+// ErrNoAddress indicates that no control address was supplied.
+var ErrNoAddress = errors.New("no chronon control address")
+
+// parseAddr splits a control address into a network and an address suitable for
+// net.Listen and net.Dial.  Addresses of the form "unix:PATH" use a Unix socket.
+// All other addresses are TCP host:port pairs.
+func parseAddr(addr string) (network, address string, err error) {
+	switch {
+	case len(addr) == 0:
+		err = ErrNoAddress
+
+	case strings.HasPrefix(addr, unixPrefix):
+		network, address = "unix", strings.TrimPrefix(addr, unixPrefix)
+
+	default:
+		network, address = "tcp", addr
+	}
+
+	return
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chrononremote
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/xmidt-org/chronon"
+)
+
+// Client is a Controller that drives a fake clock served in another process.
+type Client struct {
+	client *http.Client
+}
+
+var _ Controller = (*Client)(nil)
+
+// NewClient creates a Client for the control server at the given address, which
+// has the same form as the address passed to Listen.  No connection is made until
+// the first operation.
+func NewClient(addr string) (*Client, error) {
+	network, address, err := parseAddr(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	var dialer net.Dialer
+	return &Client{
+		client: &http.Client{
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					return dialer.DialContext(ctx, network, address)
+				},
+			},
+		},
+	}, nil
+}
+
+// NewClientFromEnv creates a Client for the address in the AddrEnv environment
+// variable.  If that variable is not set, this function returns ErrNoAddress.
+func NewClientFromEnv() (*Client, error) {
+	return NewClient(os.Getenv(AddrEnv))
+}
+
+// do performs a single control operation.
+func (c *Client) do(ctx context.Context, path string, body controlRequest) (result controlResponse, err error) {
+	var encoded bytes.Buffer
+	if err = json.NewEncoder(&encoded).Encode(body); err != nil {
+		return
+	}
+
+	// the host is ignored, since the transport always dials the control address
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, "http://chronon"+path, &encoded)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	response, err := c.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	defer response.Body.Close()
+	if err = json.NewDecoder(response.Body).Decode(&result); err != nil {
+		err = fmt.Errorf("invalid control response [%s]: %w", response.Status, err)
+		return
+	}
+
+	if response.StatusCode != http.StatusOK {
+		err = errors.New(result.Error)
+	}
+
+	return
+}
+
+func (c *Client) Now(ctx context.Context) (time.Time, error) {
+	result, err := c.do(ctx, PathNow, controlRequest{})
+	return result.Now, err
+}
+
+func (c *Client) Add(ctx context.Context, d time.Duration) (time.Time, error) {
+	result, err := c.do(ctx, PathAdd, controlRequest{Duration: d.String()})
+	return result.Now, err
+}
+
+func (c *Client) Set(ctx context.Context, t time.Time) (time.Time, error) {
+	result, err := c.do(ctx, PathSet, controlRequest{Time: &t})
+	return result.Now, err
+}
+
+func (c *Client) Pending(ctx context.Context) ([]chronon.Pending, error) {
+	result, err := c.do(ctx, PathPending, controlRequest{})
+	return result.Pending, err
+}
+
+func (c *Client) FireNext(ctx context.Context) (time.Time, bool, error) {
+	result, err := c.do(ctx, PathFire, controlRequest{})
+	return result.Now, result.Fired, err
+}
+
+func (c *Client) WaitForPending(ctx context.Context, n int) error {
+	_, err := c.do(ctx, PathWait, controlRequest{Count: n})
+	return err
+}
@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chrononremote
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/xmidt-org/chronon"
+)
+
+type ClientSuite struct {
+	suite.Suite
+
+	start time.Time
+	fc    *chronon.FakeClock
+}
+
+func (suite *ClientSuite) SetupTest() {
+	suite.start = time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	suite.fc = chronon.NewFakeClock(suite.start)
+}
+
+// listen serves the fake clock at the given address and returns a client for it.
+func (suite *ClientSuite) listen(addr string) Controller {
+	s, err := Listen(addr, NewController(suite.fc))
+	suite.Require().NoError(err)
+	suite.T().Cleanup(func() { s.Close() })
+
+	c, err := NewClient(s.Addr())
+	suite.Require().NoError(err)
+	return c
+}
+
+// testController runs the same operations against any Controller.
+func (suite *ClientSuite) testController(c Controller) {
+	ctx := context.Background()
+
+	now, err := c.Now(ctx)
+	suite.NoError(err)
+	suite.True(suite.start.Equal(now))
+
+	now, err = c.Add(ctx, time.Minute)
+	suite.NoError(err)
+	suite.True(suite.start.Add(time.Minute).Equal(now))
+
+	now, err = c.Set(ctx, suite.start)
+	suite.NoError(err)
+	suite.True(suite.start.Equal(now))
+
+	pending, err := c.Pending(ctx)
+	suite.NoError(err)
+	suite.Empty(pending)
+
+	now, fired, err := c.FireNext(ctx)
+	suite.NoError(err)
+	suite.False(fired)
+	suite.True(suite.start.Equal(now))
+
+	waited := make(chan error, 1)
+	go func() {
+		waited <- c.WaitForPending(ctx, 1)
+	}()
+
+	t := suite.fc.NewTimer(time.Second)
+	select {
+	case err := <-waited:
+		suite.NoError(err)
+	case <-time.After(time.Second):
+		suite.Fail("WaitForPending did not return")
+	}
+
+	pending, err = c.Pending(ctx)
+	suite.NoError(err)
+	suite.Require().Len(pending, 1)
+	suite.Equal(chronon.KindTimer, pending[0].Kind)
+	suite.True(suite.start.Add(time.Second).Equal(pending[0].When))
+
+	now, fired, err = c.FireNext(ctx)
+	suite.NoError(err)
+	suite.True(fired)
+	suite.True(suite.start.Add(time.Second).Equal(now))
+	suite.Len(t.C(), 1)
+
+	canceled, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	suite.ErrorIs(c.WaitForPending(canceled, 1), context.DeadlineExceeded)
+}
+
+func (suite *ClientSuite) TestLocal() {
+	suite.testController(NewController(suite.fc))
+}
+
+func (suite *ClientSuite) TestTCP() {
+	suite.testController(suite.listen("127.0.0.1:0"))
+}
+
+func (suite *ClientSuite) TestUnix() {
+	suite.testController(suite.listen("unix:" + filepath.Join(suite.T().TempDir(), "chronon.sock")))
+}
+
+func (suite *ClientSuite) TestErrors() {
+	_, err := Listen("", nil)
+	suite.ErrorIs(err, ErrNoAddress)
+
+	_, err = NewClient("")
+	suite.ErrorIs(err, ErrNoAddress)
+
+	_, err = Listen("unix:"+filepath.Join(suite.T().TempDir(), "missing", "chronon.sock"), nil)
+	suite.Error(err)
+
+	server := httptest.NewServer(NewHandler(NewController(suite.fc)))
+	defer server.Close()
+
+	for name, test := range map[string]struct {
+		method string
+		path   string
+		body   string
+		status int
+	}{
+		"Method":      {method: http.MethodGet, path: PathNow, status: http.StatusMethodNotAllowed},
+		"Body":        {method: http.MethodPost, path: PathNow, body: "{", status: http.StatusBadRequest},
+		"Duration":    {method: http.MethodPost, path: PathAdd, body: `{"duration": "x"}`, status: http.StatusBadRequest},
+		"Time":        {method: http.MethodPost, path: PathSet, body: `{}`, status: http.StatusBadRequest},
+		"EmptyBody":   {method: http.MethodPost, path: PathNow, status: http.StatusOK},
+		"UnknownPath": {method: http.MethodPost, path: "/nosuch", status: http.StatusNotFound},
+	} {
+		suite.Run(name, func() {
+			request, err := http.NewRequest(test.method, server.URL+test.path, strings.NewReader(test.body))
+			suite.Require().NoError(err)
+
+			response, err := server.Client().Do(request)
+			suite.Require().NoError(err)
+			response.Body.Close()
+			suite.Equal(test.status, response.StatusCode)
+		})
+	}
+
+	c, err := NewClient(strings.TrimPrefix(server.URL, "http://"))
+	suite.Require().NoError(err)
+
+	// the server's error is returned by the client
+	_, err = c.do(context.Background(), PathAdd, controlRequest{Duration: "x"})
+	suite.ErrorContains(err, "invalid duration")
+}
+
+func (suite *ClientSuite) TestFromEnv() {
+	suite.T().Setenv(AddrEnv, "")
+	clock, s, err := ClockFromEnv()
+	suite.NoError(err)
+	suite.Nil(s)
+	suite.True(chronon.IsSystemClock(clock))
+
+	_, err = NewClientFromEnv()
+	suite.ErrorIs(err, ErrNoAddress)
+
+	suite.T().Setenv(AddrEnv, "unix:"+filepath.Join(suite.T().TempDir(), "chronon.sock"))
+	clock, s, err = ClockFromEnv()
+	suite.Require().NoError(err)
+	suite.Require().NotNil(s)
+	defer s.Close()
+
+	fc, ok := clock.(*chronon.FakeClock)
+	suite.Require().True(ok)
+
+	c, err := NewClientFromEnv()
+	suite.Require().NoError(err)
+
+	now, err := c.Add(context.Background(), time.Hour)
+	suite.NoError(err)
+	suite.True(fc.Now().Equal(now))
+
+	suite.T().Setenv(AddrEnv, "unix:"+filepath.Join(suite.T().TempDir(), "missing", "chronon.sock"))
+	_, _, err = ClockFromEnv()
+	suite.Error(err)
+}
+
+func TestClient(t *testing.T) {
+	suite.Run(t, new(ClientSuite))
+}
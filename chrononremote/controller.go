@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+// Package chrononremote controls a *chronon.FakeClock from another process.  A service
+// under test serves its fake clock with Listen, and a test harness drives that clock with
+// a Client.  The protocol is JSON over HTTP, carried over either TCP or a Unix socket.
+package chrononremote
+
+import (
+	"context"
+	"time"
+
+	"github.com/xmidt-org/chronon"
+)
+
+// Controller is the set of operations that drive a fake clock.  Test code written
+// against this interface works the same whether the clock is in the same process,
+// via NewController, or in another process, via a Client.
+type Controller interface {
+	// Now returns the clock's current time.
+	Now(ctx context.Context) (time.Time, error)
+
+	// Add moves the clock by the given duration and returns the new time.
+	Add(ctx context.Context, d time.Duration) (time.Time, error)
+
+	// Set sets the clock's time and returns the new time.
+	Set(ctx context.Context, t time.Time) (time.Time, error)
+
+	// Pending lists the timers, tickers, and sleepers waiting on the clock.
+	Pending(ctx context.Context) ([]chronon.Pending, error)
+
+	// FireNext moves the clock to the earliest pending When, returning the new time.
+	// If nothing is pending, the clock does not change and false is returned.
+	FireNext(ctx context.Context) (time.Time, bool, error)
+
+	// WaitForPending blocks until at least n timers, tickers, or sleepers are waiting
+	// on the clock, or until the context is canceled.
+	WaitForPending(ctx context.Context, n int) error
+}
+
+// localController is a Controller for a FakeClock in this process.
+type localController struct {
+	fc *chronon.FakeClock
+}
+
+// NewController returns a Controller that drives the given FakeClock directly.
+func NewController(fc *chronon.FakeClock) Controller {
+	return localController{fc: fc}
+}
+
+func (lc localController) Now(context.Context) (time.Time, error) {
+	return lc.fc.Now(), nil
+}
+
+func (lc localController) Add(_ context.Context, d time.Duration) (time.Time, error) {
+	return lc.fc.Add(d), nil
+}
+
+func (lc localController) Set(_ context.Context, t time.Time) (time.Time, error) {
+	lc.fc.Set(t)
+	return lc.fc.Now(), nil
+}
+
+func (lc localController) Pending(context.Context) ([]chronon.Pending, error) {
+	return lc.fc.Pending(), nil
+}
+
+func (lc localController) FireNext(context.Context) (time.Time, bool, error) {
+	now, fired := lc.fc.FireNext()
+	return now, fired, nil
+}
+
+func (lc localController) WaitForPending(ctx context.Context, n int) error {
+	return lc.fc.WaitForPending(ctx, n)
+}
@@ -0,0 +1,281 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chrononremote
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/xmidt-org/chronon"
+)
+
+// The paths of the control protocol's operations.
+const (
+	PathNow     = "/now"
+	PathAdd     = "/add"
+	PathSet     = "/set"
+	PathPending = "/pending"
+	PathFire    = "/fire"
+	PathWait    = "/wait"
+)
+
+// controlRequest is the body of a control request.  Which fields apply depends on the operation.
+type controlRequest struct {
+	// Duration is the amount to add, in time.ParseDuration format.
+	Duration string `json:"duration,omitempty"`
+
+	// Time is the time to set.
+	Time *time.Time `json:"time,omitempty"`
+
+	// Count is the number of pending objects to wait for.
+	Count int `json:"count,omitempty"`
+}
+
+// controlResponse is the body of a control response.
+type controlResponse struct {
+	Now     time.Time         `json:"now"`
+	Pending []chronon.Pending `json:"pending,omitempty"`
+	Fired   bool              `json:"fired,omitempty"`
+	Error   string            `json:"error,omitempty"`
+}
+
+// controlHandler serves the control protocol for a Controller.
+type controlHandler struct {
+	c Controller
+}
+
+// NewHandler returns an http.Handler that serves the control protocol for the given
+// Controller.  Each operation is a POST to one of the Path constants with a JSON body.
+// Every response is a JSON object that includes the clock's current time.
+func NewHandler(c Controller) http.Handler {
+	ch := controlHandler{c: c}
+	mux := http.NewServeMux()
+	mux.HandleFunc(PathNow, ch.now)
+	mux.HandleFunc(PathAdd, ch.add)
+	mux.HandleFunc(PathSet, ch.set)
+	mux.HandleFunc(PathPending, ch.pending)
+	mux.HandleFunc(PathFire, ch.fire)
+	mux.HandleFunc(PathWait, ch.wait)
+	return mux
+}
+
+// decode reads a control request.  If the request is not valid, an error
+// response is written and this method returns false.
+func (ch controlHandler) decode(response http.ResponseWriter, request *http.Request, body *controlRequest) bool {
+	if request.Method != http.MethodPost {
+		ch.encode(response, http.StatusMethodNotAllowed, nil, errors.New("control requests must use POST"))
+		return false
+	}
+
+	if err := json.NewDecoder(request.Body).Decode(body); err != nil && !errors.Is(err, io.EOF) {
+		ch.encode(response, http.StatusBadRequest, nil, err)
+		return false
+	}
+
+	return true
+}
+
+// encode writes a control response.  If err is not nil, the response carries the error.
+func (ch controlHandler) encode(response http.ResponseWriter, status int, body *controlResponse, err error) {
+	if body == nil {
+		body = new(controlResponse)
+	}
+
+	if err != nil {
+		body.Error = err.Error()
+	}
+
+	response.Header().Set("Content-Type", "application/json")
+	response.WriteHeader(status)
+	json.NewEncoder(response).Encode(body) // nolint:errcheck
+}
+
+// reply writes the outcome of an operation, filling in the current time if necessary.
+func (ch controlHandler) reply(response http.ResponseWriter, request *http.Request, body *controlResponse, err error) {
+	if err == nil && body.Now.IsZero() {
+		body.Now, err = ch.c.Now(request.Context())
+	}
+
+	status := http.StatusOK
+	if err != nil {
+		status = http.StatusInternalServerError
+	}
+
+	ch.encode(response, status, body, err)
+}
+
+func (ch controlHandler) now(response http.ResponseWriter, request *http.Request) {
+	var body controlRequest
+	if ch.decode(response, request, &body) {
+		ch.reply(response, request, new(controlResponse), nil)
+	}
+}
+
+func (ch controlHandler) add(response http.ResponseWriter, request *http.Request) {
+	var body controlRequest
+	if !ch.decode(response, request, &body) {
+		return
+	}
+
+	d, err := time.ParseDuration(body.Duration)
+	if err != nil {
+		ch.encode(response, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	var result controlResponse
+	result.Now, err = ch.c.Add(request.Context(), d)
+	ch.reply(response, request, &result, err)
+}
+
+func (ch controlHandler) set(response http.ResponseWriter, request *http.Request) {
+	var body controlRequest
+	if !ch.decode(response, request, &body) {
+		return
+	}
+
+	if body.Time == nil {
+		ch.encode(response, http.StatusBadRequest, nil, errors.New("no time supplied"))
+		return
+	}
+
+	var (
+		result controlResponse
+		err    error
+	)
+
+	result.Now, err = ch.c.Set(request.Context(), *body.Time)
+	ch.reply(response, request, &result, err)
+}
+
+func (ch controlHandler) pending(response http.ResponseWriter, request *http.Request) {
+	var body controlRequest
+	if !ch.decode(response, request, &body) {
+		return
+	}
+
+	var (
+		result controlResponse
+		err    error
+	)
+
+	result.Pending, err = ch.c.Pending(request.Context())
+	ch.reply(response, request, &result, err)
+}
+
+func (ch controlHandler) fire(response http.ResponseWriter, request *http.Request) {
+	var body controlRequest
+	if !ch.decode(response, request, &body) {
+		return
+	}
+
+	var (
+		result controlResponse
+		err    error
+	)
+
+	result.Now, result.Fired, err = ch.c.FireNext(request.Context())
+	ch.reply(response, request, &result, err)
+}
+
+func (ch controlHandler) wait(response http.ResponseWriter, request *http.Request) {
+	var body controlRequest
+	if !ch.decode(response, request, &body) {
+		return
+	}
+
+	var result controlResponse
+	err := ch.c.WaitForPending(request.Context(), body.Count)
+	if err == nil {
+		result.Pending, err = ch.c.Pending(request.Context())
+	}
+
+	ch.reply(response, request, &result, err)
+}
+
+// Server serves the control protocol on a listener.
+type Server struct {
+	addr     string
+	listener net.Listener
+	server   *http.Server
+	done     chan struct{}
+}
+
+// Listen starts serving the control protocol for the given Controller at the given
+// address.  An address of the form "unix:PATH" listens on a Unix socket, removing any
+// stale socket file first.  Any other address is a TCP host:port, where a port of 0
+// chooses a free port.  Use Addr to obtain the address clients should connect to.
+func Listen(addr string, c Controller) (*Server, error) {
+	network, address, err := parseAddr(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if network == "unix" {
+		os.Remove(address) // nolint:errcheck
+	}
+
+	l, err := net.Listen(network, address)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		addr:     addr,
+		listener: l,
+		server: &http.Server{
+			Handler:           NewHandler(c),
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+		done: make(chan struct{}),
+	}
+
+	if network == "tcp" {
+		s.addr = l.Addr().String()
+	}
+
+	go func() {
+		defer close(s.done)
+		s.server.Serve(l) // nolint:errcheck
+	}()
+
+	return s, nil
+}
+
+// Addr returns the address at which this Server listens, in the form accepted by NewClient.
+func (s *Server) Addr() string {
+	return s.addr
+}
+
+// Close stops this Server.  Any operations in progress, such as WaitForPending, are abandoned.
+func (s *Server) Close() error {
+	err := s.server.Close()
+	<-s.done
+	return err
+}
+
+// ClockFromEnv returns the Clock a service should use, based on the AddrEnv environment
+// variable.  If the variable is not set, this function returns chronon.SystemClock() and
+// a nil Server.  Otherwise, it returns a *chronon.FakeClock frozen at the current time,
+// along with the Server that exposes that clock at the configured address.
+func ClockFromEnv() (chronon.Clock, *Server, error) {
+	addr := os.Getenv(AddrEnv)
+	if len(addr) == 0 {
+		return chronon.SystemClock(), nil, nil
+	}
+
+	fc := chronon.NewFakeClock(time.Now())
+	s, err := Listen(addr, NewController(fc))
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to serve chronon control at %s: %w", addr, err)
+	}
+
+	return fc, s, nil
+}
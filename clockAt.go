@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import "time"
+
+// ClockAt is a Clock that can also schedule events at absolute times.  Computing
+// a duration with Until and then passing it to a Clock method races with the clock
+// moving.  The methods of this interface avoid that race.
+//
+// Both SystemClock() and *FakeClock implement this interface.  Use At to obtain
+// a ClockAt from an arbitrary Clock.
+type ClockAt interface {
+	Clock
+
+	// NewTimerAt produces a Timer which emits its single event at the given time.
+	NewTimerAt(time.Time) Timer
+
+	// AfterFuncAt invokes the given function at the given time.  The returned
+	// Timer can be used to halt execution.
+	AfterFuncAt(time.Time, func()) Timer
+
+	// AfterAt returns a timer channel which receives a time at the given time.
+	AfterAt(time.Time) <-chan time.Time
+
+	// SleepUntil blocks until this clock reaches the given time.
+	SleepUntil(time.Time)
+}
+
+// At returns a ClockAt for the given Clock.  If c already implements ClockAt, it is
+// returned as is.  Otherwise, the returned ClockAt converts each absolute time into
+// a duration using c.Until, which is subject to the clock moving between the two calls.
+func At(c Clock) ClockAt {
+	if ca, ok := c.(ClockAt); ok {
+		return ca
+	}
+
+	return clockAt{Clock: c}
+}
+
+// clockAt adapts an arbitrary Clock to the ClockAt interface.
+type clockAt struct {
+	Clock
+}
+
+func (ca clockAt) NewTimerAt(t time.Time) Timer {
+	return ca.NewTimer(ca.Until(t))
+}
+
+func (ca clockAt) AfterFuncAt(t time.Time, f func()) Timer {
+	return ca.AfterFunc(ca.Until(t), f)
+}
+
+func (ca clockAt) AfterAt(t time.Time) <-chan time.Time {
+	return ca.After(ca.Until(t))
+}
+
+func (ca clockAt) SleepUntil(t time.Time) {
+	ca.Sleep(ca.Until(t))
+}
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// plainClock hides any optional interfaces implemented by a Clock.
+type plainClock struct {
+	Clock
+}
+
+type ClockAtSuite struct {
+	ChrononSuite
+}
+
+func (suite *ClockAtSuite) TestAt() {
+	suite.Run("SystemClock", func() {
+		suite.Equal(SystemClock(), At(SystemClock()))
+	})
+
+	suite.Run("FakeClock", func() {
+		fc := suite.newFakeClock()
+		suite.Same(fc, At(fc))
+	})
+
+	suite.Run("Adapter", func() {
+		fc := suite.newFakeClock()
+		ca := At(plainClock{Clock: fc})
+		suite.Require().NotNil(ca)
+
+		wakeup := suite.now.Add(TestInterval)
+		t := ca.NewTimerAt(wakeup)
+		suite.Equal(wakeup, t.(FakeTimer).When())
+
+		ch := ca.AfterAt(wakeup)
+		called := make(chan struct{}, 1)
+		ca.AfterFuncAt(wakeup, func() { called <- struct{}{} })
+
+		fc.Set(wakeup)
+		suite.requireSignal(t.C(), Immediate)
+		suite.requireSignal(ch, Immediate)
+		suite.requireSignal(called, Immediate)
+
+		// the wakeup time has passed, so this returns immediately
+		ca.SleepUntil(wakeup)
+	})
+}
+
+func (suite *ClockAtSuite) TestFakeClock() {
+	// use a wakeup time without a monotonic reading, which could be
+	// altered by converting it into a duration
+	wakeup := suite.now.Round(0).Add(TestInterval)
+
+	suite.Run("NewTimerAt", func() {
+		fc := suite.newFakeClock()
+		t := fc.NewTimerAt(wakeup)
+		suite.Equal(wakeup, t.(FakeTimer).When())
+
+		fc.Add(TestInterval)
+		suite.requireSignal(t.C(), Immediate)
+	})
+
+	suite.Run("AfterAt", func() {
+		fc := suite.newFakeClock()
+		onTimer := make(chan FakeTimer, 1)
+		fc.NotifyOnTimer(onTimer)
+
+		ch := fc.AfterAt(wakeup)
+		ft := suite.requireReceive(onTimer, Immediate).(FakeTimer)
+		suite.Equal(wakeup, ft.When())
+
+		fc.Add(TestInterval)
+		suite.requireSignal(ch, Immediate)
+	})
+
+	suite.Run("AfterFuncAt", func() {
+		fc := suite.newFakeClock()
+		called := make(chan struct{}, 1)
+		t := fc.AfterFuncAt(wakeup, func() { called <- struct{}{} })
+		suite.Equal(wakeup, t.(FakeTimer).When())
+
+		fc.Add(TestInterval)
+		suite.requireSignal(called, Immediate)
+	})
+
+	suite.Run("SleepUntil", func() {
+		fc := suite.newFakeClock()
+		onSleep := make(chan Sleeper, 1)
+		fc.NotifyOnSleep(onSleep)
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			fc.SleepUntil(wakeup)
+		}()
+
+		s := suite.requireReceive(onSleep, WaitALittle).(Sleeper)
+		suite.Equal(wakeup, s.When())
+
+		fc.Add(TestInterval)
+		suite.requireSignal(done, WaitALittle)
+	})
+}
+
+func (suite *ClockAtSuite) TestSystemClock() {
+	ca := At(SystemClock())
+	wakeup := time.Now().Add(10 * time.Millisecond)
+
+	t := ca.NewTimerAt(wakeup)
+	defer t.Stop()
+
+	called := make(chan struct{})
+	ca.AfterFuncAt(wakeup, func() { close(called) })
+
+	ch := ca.AfterAt(wakeup)
+	ca.SleepUntil(wakeup)
+	suite.False(time.Now().Before(wakeup))
+
+	suite.requireSignal(t.C(), WaitALittle)
+	suite.requireSignal(called, WaitALittle)
+	suite.requireSignal(ch, WaitALittle)
+}
+
+func TestClockAt(t *testing.T) {
+	suite.Run(t, new(ClockAtSuite))
+}
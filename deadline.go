@@ -0,0 +1,226 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// deadlineCtx is a context whose deadline is enforced by a Clock.
+//
+// The inner context handles cancellation from the parent as well as through the
+// CancelFunc, and it holds the cause.  A deadlineCtx has its own done channel, which
+// keeps the context package from attaching derived contexts directly to the inner
+// context, where they would observe context.Canceled rather than context.DeadlineExceeded.
+// Instead, derived contexts register through its AfterFunc method, exactly as with
+// contexts derived from any other custom context.
+type deadlineCtx struct {
+	inner       context.Context
+	innerCancel context.CancelCauseFunc
+
+	deadline time.Time
+	done     chan struct{}
+	doneOnce sync.Once
+
+	lock     sync.Mutex
+	exceeded bool
+	timer    Timer
+	stop     func() bool // stops the inner context's AfterFunc
+	funcs    map[*func()]bool
+}
+
+var _ context.Context = (*deadlineCtx)(nil)
+
+func (dc *deadlineCtx) Deadline() (time.Time, bool) {
+	return dc.deadline, true
+}
+
+func (dc *deadlineCtx) Done() <-chan struct{} {
+	return dc.done
+}
+
+// isDone tests if this context's done channel has been closed.
+func (dc *deadlineCtx) isDone() bool {
+	select {
+	case <-dc.done:
+		return true
+
+	default:
+		return false
+	}
+}
+
+// Err returns context.DeadlineExceeded if this context's deadline passed
+// before it was canceled.  Otherwise, the inner context's error is returned.
+func (dc *deadlineCtx) Err() error {
+	if !dc.isDone() {
+		return nil
+	}
+
+	dc.lock.Lock()
+	defer dc.lock.Unlock()
+	if dc.exceeded {
+		return context.DeadlineExceeded
+	}
+
+	return dc.inner.Err()
+}
+
+// Value delegates to the inner context, which allows context.Cause to report the cause.
+func (dc *deadlineCtx) Value(key any) any {
+	return dc.inner.Value(key)
+}
+
+// AfterFunc arranges for f to be called once this context is done.  The context
+// package uses this method to propagate cancellation to derived contexts.  The returned
+// function prevents f from being called, returning false if f was already called.
+func (dc *deadlineCtx) AfterFunc(f func()) func() bool {
+	dc.lock.Lock()
+	defer dc.lock.Unlock()
+
+	if dc.funcs == nil {
+		dc.funcs = make(map[*func()]bool)
+	}
+
+	key := &f
+	dc.funcs[key] = true
+	if dc.isDone() {
+		go dc.runFuncs()
+	}
+
+	return func() (stopped bool) {
+		dc.lock.Lock()
+		stopped = dc.funcs[key]
+		delete(dc.funcs, key)
+		dc.lock.Unlock()
+		return
+	}
+}
+
+// finish closes this context's done channel, then runs the functions registered
+// with AfterFunc.  This method is idempotent.
+func (dc *deadlineCtx) finish() {
+	dc.doneOnce.Do(func() {
+		close(dc.done)
+	})
+
+	dc.runFuncs()
+}
+
+// runFuncs is called once this context is done.  It stops the deadline timer,
+// then invokes and removes all functions registered with AfterFunc.
+func (dc *deadlineCtx) runFuncs() {
+	dc.lock.Lock()
+	funcs, timer := dc.funcs, dc.timer
+	dc.funcs, dc.timer = nil, nil
+	dc.lock.Unlock()
+
+	if timer != nil {
+		timer.Stop()
+	}
+
+	for f := range funcs {
+		(*f)()
+	}
+}
+
+// cancel handles both the deadline passing and the CancelFunc being invoked.
+// Derived contexts are canceled before this method returns.
+func (dc *deadlineCtx) cancel(exceeded bool, cause error) {
+	dc.lock.Lock()
+	if dc.inner.Err() != nil {
+		dc.lock.Unlock()
+		return
+	}
+
+	dc.exceeded = exceeded
+	dc.lock.Unlock()
+
+	dc.innerCancel(cause)
+	dc.stop()
+	dc.finish()
+}
+
+// WithDeadlineCause is like context.WithDeadlineCause, except that the deadline is
+// enforced by the given Clock.  The returned context's Done channel is closed when the
+// clock reaches the deadline, when the returned cancel function is called, or when the
+// parent's Done channel is closed, whichever happens first.  Once the deadline passes, the
+// context's Err method returns context.DeadlineExceeded and context.Cause returns the given
+// cause, or context.DeadlineExceeded if cause is nil.
+//
+// If clock is the SystemClock, this function simply delegates to context.WithDeadlineCause.
+func WithDeadlineCause(parent context.Context, clock Clock, d time.Time, cause error) (context.Context, context.CancelFunc) {
+	if IsSystemClock(clock) {
+		return context.WithDeadlineCause(parent, d, cause)
+	}
+
+	if cur, ok := parent.Deadline(); ok && cur.Before(d) {
+		// the current deadline is already sooner than the new one
+		return context.WithCancel(parent)
+	}
+
+	if cause == nil {
+		cause = context.DeadlineExceeded
+	}
+
+	dc := &deadlineCtx{
+		deadline: d,
+		done:     make(chan struct{}),
+	}
+
+	dc.inner, dc.innerCancel = context.WithCancelCause(parent)
+
+	// propagates cancellation from the parent to this context and those derived from it
+	dc.stop = context.AfterFunc(dc.inner, dc.finish)
+
+	if dur := clock.Until(d); dur > 0 {
+		// the lock must not be held here, since a clock may invoke the function immediately
+		t := clock.AfterFunc(dur, func() { dc.cancel(true, cause) })
+
+		dc.lock.Lock()
+		if dc.inner.Err() == nil {
+			dc.timer = t
+		} else {
+			defer t.Stop()
+		}
+
+		dc.lock.Unlock()
+	} else {
+		dc.cancel(true, cause)
+	}
+
+	return dc, func() { dc.cancel(false, nil) }
+}
+
+// WithDeadline is like context.WithDeadline, except that the deadline is enforced by the
+// given Clock.  See WithDeadlineCause for details.
+func WithDeadline(parent context.Context, clock Clock, d time.Time) (context.Context, context.CancelFunc) {
+	return WithDeadlineCause(parent, clock, d, nil)
+}
+
+// WithTimeoutCause is like context.WithTimeoutCause, except that the timeout is enforced
+// by the given Clock.  The deadline is computed from the clock's current time.
+func WithTimeoutCause(parent context.Context, clock Clock, timeout time.Duration, cause error) (context.Context, context.CancelFunc) {
+	return WithDeadlineCause(parent, clock, clock.Now().Add(timeout), cause)
+}
+
+// WithTimeout is like context.WithTimeout, except that the timeout is enforced by the
+// given Clock.  The deadline is computed from the clock's current time.
+func WithTimeout(parent context.Context, clock Clock, timeout time.Duration) (context.Context, context.CancelFunc) {
+	return WithTimeoutCause(parent, clock, timeout, nil)
+}
+
+// ContextWithDeadline is like WithDeadline, except that it uses the Clock associated
+// with the parent context.  See Get.
+func ContextWithDeadline(parent context.Context, d time.Time) (context.Context, context.CancelFunc) {
+	return WithDeadline(parent, Get(parent), d)
+}
+
+// ContextWithTimeout is like WithTimeout, except that it uses the Clock associated
+// with the parent context.  See Get.
+func ContextWithTimeout(parent context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	return WithTimeout(parent, Get(parent), timeout)
+}
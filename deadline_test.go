@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DeadlineSuite struct {
+	ChrononSuite
+}
+
+func (suite *DeadlineSuite) requireNotDone(ctx context.Context) {
+	suite.T().Helper()
+	suite.requireNoSignal(ctx.Done(), Immediate)
+	suite.NoError(ctx.Err())
+	suite.NoError(context.Cause(ctx))
+}
+
+func (suite *DeadlineSuite) requireDone(ctx context.Context, err, cause error) {
+	suite.T().Helper()
+	suite.requireSignal(ctx.Done(), Immediate)
+	suite.Equal(err, ctx.Err())
+	suite.Equal(cause, context.Cause(ctx))
+}
+
+func (suite *DeadlineSuite) TestWithTimeout() {
+	fc := suite.newFakeClock()
+	ctx, cancel := WithTimeout(context.Background(), fc, TestInterval)
+	defer cancel()
+
+	child, childCancel := context.WithCancel(ctx)
+	defer childCancel()
+
+	deadline, ok := ctx.Deadline()
+	suite.True(ok)
+	suite.Equal(suite.now.Add(TestInterval), deadline)
+
+	suite.requireNotDone(ctx)
+	suite.requireNotDone(child)
+
+	fc.Add(TestInterval)
+	suite.requireDone(ctx, context.DeadlineExceeded, context.DeadlineExceeded)
+	suite.requireDone(child, context.DeadlineExceeded, context.DeadlineExceeded)
+
+	// contexts derived after the deadline are done immediately
+	late, lateCancel := context.WithCancel(ctx)
+	defer lateCancel()
+	suite.requireDone(late, context.DeadlineExceeded, context.DeadlineExceeded)
+
+	// canceling after the deadline has no effect
+	cancel()
+	suite.requireDone(ctx, context.DeadlineExceeded, context.DeadlineExceeded)
+}
+
+func (suite *DeadlineSuite) TestWithTimeoutCause() {
+	var (
+		fc    = suite.newFakeClock()
+		cause = errors.New("expected")
+	)
+
+	ctx, cancel := WithTimeoutCause(context.Background(), fc, TestInterval, cause)
+	defer cancel()
+
+	child, childCancel := context.WithCancel(ctx)
+	defer childCancel()
+
+	fc.Add(TestInterval)
+	suite.requireDone(ctx, context.DeadlineExceeded, cause)
+	suite.requireDone(child, context.DeadlineExceeded, cause)
+}
+
+func (suite *DeadlineSuite) TestCancel() {
+	fc := suite.newFakeClock()
+	ctx, cancel := WithDeadline(context.Background(), fc, suite.now.Add(TestInterval))
+
+	child, childCancel := context.WithCancel(ctx)
+	defer childCancel()
+
+	cancel()
+	suite.requireDone(ctx, context.Canceled, context.Canceled)
+	suite.requireDone(child, context.Canceled, context.Canceled)
+
+	fc.Add(TestInterval)
+	suite.requireDone(ctx, context.Canceled, context.Canceled)
+}
+
+func (suite *DeadlineSuite) TestParentCanceled() {
+	var (
+		fc    = suite.newFakeClock()
+		cause = errors.New("expected")
+	)
+
+	parent, parentCancel := context.WithCancelCause(context.Background())
+	ctx, cancel := WithTimeout(parent, fc, TestInterval)
+	defer cancel()
+
+	child, childCancel := context.WithCancel(ctx)
+	defer childCancel()
+
+	// cancellation from the parent propagates asynchronously
+	parentCancel(cause)
+	suite.requireSignal(ctx.Done(), WaitALittle)
+	suite.requireDone(ctx, context.Canceled, cause)
+	suite.requireSignal(child.Done(), WaitALittle)
+	suite.Equal(context.Canceled, child.Err())
+	suite.Equal(cause, context.Cause(child))
+
+	fc.Add(TestInterval)
+	suite.requireDone(ctx, context.Canceled, cause)
+}
+
+func (suite *DeadlineSuite) TestParentDeadlineSooner() {
+	fc := suite.newFakeClock()
+	parent, parentCancel := WithTimeout(context.Background(), fc, TestInterval)
+	defer parentCancel()
+
+	ctx, cancel := WithTimeout(parent, fc, time.Hour)
+	defer cancel()
+
+	deadline, ok := ctx.Deadline()
+	suite.True(ok)
+	suite.Equal(suite.now.Add(TestInterval), deadline)
+
+	fc.Add(TestInterval)
+	suite.requireDone(ctx, context.DeadlineExceeded, context.DeadlineExceeded)
+}
+
+func (suite *DeadlineSuite) TestPastDeadline() {
+	fc := suite.newFakeClock()
+	for _, d := range []time.Duration{-TestInterval, 0} {
+		suite.Run(d.String(), func() {
+			ctx, cancel := WithTimeout(context.Background(), fc, d)
+			defer cancel()
+			suite.requireDone(ctx, context.DeadlineExceeded, context.DeadlineExceeded)
+		})
+	}
+}
+
+func (suite *DeadlineSuite) TestSystemClock() {
+	ctx, cancel := WithTimeout(context.Background(), SystemClock(), 10*time.Millisecond)
+	defer cancel()
+
+	_, ok := ctx.Deadline()
+	suite.True(ok)
+	suite.requireSignal(ctx.Done(), WaitALittle)
+	suite.Equal(context.DeadlineExceeded, ctx.Err())
+}
+
+func (suite *DeadlineSuite) TestContextWithTimeout() {
+	fc := suite.newFakeClock()
+	ctx, cancel := ContextWithTimeout(With(context.Background(), fc), TestInterval)
+	defer cancel()
+
+	suite.requireNotDone(ctx)
+	fc.Add(TestInterval)
+	suite.requireDone(ctx, context.DeadlineExceeded, context.DeadlineExceeded)
+}
+
+func (suite *DeadlineSuite) TestContextWithDeadline() {
+	fc := suite.newFakeClock()
+	ctx, cancel := ContextWithDeadline(With(context.Background(), fc), suite.now.Add(TestInterval))
+	defer cancel()
+
+	suite.requireNotDone(ctx)
+	fc.Set(suite.now.Add(TestInterval))
+	suite.requireDone(ctx, context.DeadlineExceeded, context.DeadlineExceeded)
+}
+
+func TestDeadline(t *testing.T) {
+	suite.Run(t, new(DeadlineSuite))
+}
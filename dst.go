@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Zone is a named UTC offset in effect in some location, such as EST or EDT.
+type Zone struct {
+	// Name is the abbreviated name of the zone.
+	Name string
+
+	// Offset is the zone's offset east of UTC, in seconds.
+	Offset int
+}
+
+// zoneAt returns the zone in effect at the given time.
+func zoneAt(t time.Time) (z Zone) {
+	z.Name, z.Offset = t.Zone()
+	return
+}
+
+// Transition describes a change in a location's UTC offset, typically the start or
+// end of daylight saving time.
+type Transition struct {
+	// At is the first instant at which the new zone is in effect, in the transition's location.
+	At time.Time
+
+	// Before is the zone in effect immediately before the transition.
+	Before Zone
+
+	// After is the zone in effect from the transition onward.
+	After Zone
+}
+
+// Shift is the amount by which local wall clocks change at this transition.  Clocks spring
+// forward when this value is positive, and they fall back when this value is negative.
+func (t Transition) Shift() time.Duration {
+	return time.Duration(t.After.Offset-t.Before.Offset) * time.Second
+}
+
+// String returns a human-readable description of this transition.
+func (t Transition) String() string {
+	return fmt.Sprintf("%s: %s -> %s (%s)", t.At.Format(time.RFC3339), t.Before.Name, t.After.Name, t.Shift())
+}
+
+// NextTransition returns the first transition in loc strictly after t.  If loc has no
+// further transitions, this function returns false.
+func NextTransition(loc *time.Location, t time.Time) (Transition, bool) {
+	t = t.In(loc)
+	for {
+		_, end := t.ZoneBounds()
+		if end.IsZero() {
+			return Transition{}, false
+		}
+
+		before, after := zoneAt(end.Add(-time.Nanosecond)), zoneAt(end)
+		if before != after {
+			return Transition{
+				At:     end,
+				Before: before,
+				After:  after,
+			}, true
+		}
+
+		// some zone changes, such as renames, leave the offset and name unchanged
+		t = end
+	}
+}
+
+// TransitionStep reports the local wall times observed on a FakeClock as it
+// was moved through a Transition.
+type TransitionStep struct {
+	Transition
+
+	// Before is the clock's time, in the transition's location, before the transition.
+	Before time.Time
+
+	// After is the clock's time, in the transition's location, after the transition.
+	After time.Time
+}
+
+// Elapsed is the amount of time that actually passed between Before and After.
+func (ts TransitionStep) Elapsed() time.Duration {
+	return ts.After.Sub(ts.Before)
+}
+
+// WallChange is the difference between the local wall clock readings of Before and After.
+// This differs from Elapsed by the transition's Shift.
+func (ts TransitionStep) WallChange() time.Duration {
+	return wallTime(ts.After).Sub(wallTime(ts.Before))
+}
+
+// wallTime returns t's local wall clock reading as though it were a UTC time.
+func wallTime(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// SetBeforeTransition moves this clock to the given duration before the next transition
+// in loc after this clock's current time.  That transition is returned.  If loc has no
+// further transitions, this clock is not changed and this method returns false.
+//
+// Moving this clock works as with Set, so any timers, tickers, and sleepers due before
+// the new time fire.
+func (fc *FakeClock) SetBeforeTransition(loc *time.Location, d time.Duration) (Transition, bool) {
+	t, ok := NextTransition(loc, fc.Now())
+	if ok {
+		fc.Set(t.At.Add(-d))
+	}
+
+	return t, ok
+}
+
+// StepTransition moves this clock through the next transition in loc.  This clock is
+// first moved to the given duration before the transition, as with SetBeforeTransition,
+// and then it is advanced by twice that duration so that it ends the same duration after
+// the transition.  The local wall times at each of those points are reported.  If loc has
+// no further transitions, this clock is not changed and this method returns false.
+//
+// For example, stepping through a spring forward transition in America/New_York with a
+// duration of one minute moves the wall clock from 01:59 to 03:01.
+func (fc *FakeClock) StepTransition(loc *time.Location, d time.Duration) (ts TransitionStep, ok bool) {
+	ts.Transition, ok = fc.SetBeforeTransition(loc, d)
+	if ok {
+		ts.Before = fc.Now().In(loc)
+		ts.After = fc.Add(2 * d).In(loc)
+	}
+
+	return
+}
+
+// Fires advances fc to the given end time and returns the times a schedule sends on c
+// along the way.  A schedule is code under test that runs something periodically, such
+// as a daily job, using fc.  Fires moves fc from one pending timer, ticker, or sleeper to
+// the next, and after each one fires it waits for the schedule to send on c.
+//
+// The schedule must be the only user of fc, and it must send exactly one value on c each
+// time one of its timers, tickers, or sleepers fires.  A ticker's channel can be passed
+// directly.  If the schedule runs in its own goroutine, Fires waits for that goroutine
+// to rearm before moving fc again.
+//
+// If ctx is canceled, the times received so far are returned along with ctx.Err().
+func Fires(ctx context.Context, fc *FakeClock, c <-chan time.Time, end time.Time) (fires []time.Time, err error) {
+	for err == nil {
+		if err = fc.WaitForPending(ctx, 1); err != nil {
+			break
+		}
+
+		if next, ok := fc.nextPending(); !ok || next.After(end) {
+			break
+		}
+
+		fc.FireNext()
+		select {
+		case t := <-c:
+			fires = append(fires, t)
+
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	}
+
+	if err == nil && fc.Now().Before(end) {
+		fc.Set(end)
+	}
+
+	return
+}
+
+// ExpectFires is like Fires, except that it also returns an error if the schedule did
+// not fire exactly n times.  This allows a test to assert, for example, that a daily job
+// runs exactly once on the day that daylight saving time begins.
+func ExpectFires(ctx context.Context, fc *FakeClock, c <-chan time.Time, end time.Time, n int) ([]time.Time, error) {
+	fires, err := Fires(ctx, fc, c, end)
+	if err == nil && len(fires) != n {
+		err = fmt.Errorf("expected %d fires before %s, got %d: %v", n, end.Format(time.RFC3339), len(fires), fires)
+	}
+
+	return fires, err
+}
+
+// nextPending returns the earliest time at which a pending timer, ticker,
+// or sleeper fires.  If nothing is pending, this method returns false.
+func (fc *FakeClock) nextPending() (time.Time, bool) {
+	fc.lock.RLock()
+	defer fc.lock.RUnlock()
+	return fc.next()
+}
@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"context"
+	"testing"
+	"time"
+	_ "time/tzdata" // the tests must not depend on the host's zoneinfo
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DSTSuite struct {
+	ChrononSuite
+
+	newYork *time.Location
+}
+
+func (suite *DSTSuite) SetupSuite() {
+	suite.ChrononSuite.SetupSuite()
+
+	var err error
+	suite.newYork, err = time.LoadLocation("America/New_York")
+	suite.Require().NoError(err)
+}
+
+// newClock creates a FakeClock at the given wall time in New York.
+func (suite *DSTSuite) newClock(wall string) *FakeClock {
+	fc, err := NewFakeClockInZone("America/New_York", wall)
+	suite.Require().NoError(err)
+	return fc
+}
+
+// date returns a time in New York.
+func (suite *DSTSuite) date(month time.Month, day, hour, minute int) time.Time {
+	return time.Date(2024, month, day, hour, minute, 0, 0, suite.newYork)
+}
+
+// daily runs a schedule that fires at the given local hour and minute each day.
+// The schedule computes each run from the wall clock, as a correct scheduler should.
+func (suite *DSTSuite) daily(ctx context.Context, c Clock, hour, minute int) <-chan time.Time {
+	fires := make(chan time.Time)
+	go func() {
+		for {
+			now := c.Now().In(suite.newYork)
+			next := time.Date(now.Year(), now.Month(), now.Day(), hour, minute, 0, 0, suite.newYork)
+			if !next.After(now) {
+				next = time.Date(now.Year(), now.Month(), now.Day()+1, hour, minute, 0, 0, suite.newYork)
+			}
+
+			t := c.NewTimer(c.Until(next))
+			select {
+			case <-ctx.Done():
+				t.Stop()
+				return
+
+			case fired := <-t.C():
+				select {
+				case fires <- fired.In(suite.newYork):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return fires
+}
+
+func (suite *DSTSuite) TestNextTransition() {
+	t, ok := NextTransition(suite.newYork, suite.date(time.January, 1, 0, 0))
+	suite.Require().True(ok)
+	suite.True(suite.date(time.March, 10, 3, 0).Equal(t.At))
+	suite.Equal(Zone{Name: "EST", Offset: -5 * 60 * 60}, t.Before)
+	suite.Equal(Zone{Name: "EDT", Offset: -4 * 60 * 60}, t.After)
+	suite.Equal(time.Hour, t.Shift())
+	suite.Equal("2024-03-10T03:00:00-04:00: EST -> EDT (1h0m0s)", t.String())
+
+	t, ok = NextTransition(suite.newYork, t.At)
+	suite.Require().True(ok)
+	suite.True(time.Date(2024, time.November, 3, 6, 0, 0, 0, time.UTC).Equal(t.At))
+	suite.Equal(-time.Hour, t.Shift())
+
+	_, ok = NextTransition(time.UTC, suite.now)
+	suite.False(ok)
+}
+
+func (suite *DSTSuite) TestSetBeforeTransition() {
+	fc := suite.newClock("2024-01-01 00:00:00")
+	t, ok := fc.SetBeforeTransition(suite.newYork, time.Minute)
+	suite.Require().True(ok)
+	suite.Equal(time.Minute, fc.Until(t.At))
+	suite.Equal("01:59", fc.Now().Format("15:04"))
+
+	fc = NewFakeClock(suite.now.In(time.UTC))
+	_, ok = fc.SetBeforeTransition(time.UTC, time.Minute)
+	suite.False(ok)
+	suite.Equal(suite.now.In(time.UTC), fc.Now())
+}
+
+func (suite *DSTSuite) TestStepTransition() {
+	fc := suite.newClock("2024-01-01 00:00:00")
+
+	spring, ok := fc.StepTransition(suite.newYork, time.Minute)
+	suite.Require().True(ok)
+	suite.Equal("01:59 EST", spring.Before.Format("15:04 MST"))
+	suite.Equal("03:01 EDT", spring.After.Format("15:04 MST"))
+	suite.Equal(2*time.Minute, spring.Elapsed())
+	suite.Equal(62*time.Minute, spring.WallChange())
+
+	fall, ok := fc.StepTransition(suite.newYork, time.Minute)
+	suite.Require().True(ok)
+	suite.Equal("01:59 EDT", fall.Before.Format("15:04 MST"))
+	suite.Equal("01:01 EST", fall.After.Format("15:04 MST"))
+	suite.Equal(2*time.Minute, fall.Elapsed())
+	suite.Equal(-58*time.Minute, fall.WallChange())
+
+	// monotonic time continues to advance normally
+	suite.True(fall.After.After(fall.Before))
+}
+
+func (suite *DSTSuite) TestDailyAcrossSpringForward() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	fc := suite.newClock("2024-03-09 00:00:00")
+	fires, err := ExpectFires(ctx, fc, suite.daily(ctx, fc, 0, 30), suite.date(time.March, 12, 0, 0), 3)
+	suite.Require().NoError(err)
+	for _, f := range fires {
+		suite.Equal("00:30", f.Format("15:04"))
+	}
+
+	suite.True(suite.date(time.March, 12, 0, 0).Equal(fc.Now()))
+}
+
+func (suite *DSTSuite) TestHourlyAcrossFallBack() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// the day that daylight saving time ends has 25 hours
+	fc := suite.newClock("2024-11-03 00:00:00")
+	ticker := fc.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	fires, err := ExpectFires(ctx, fc, ticker.C(), suite.date(time.November, 4, 0, 0), 25)
+	suite.Require().NoError(err)
+	suite.Equal("01:00 EDT", fires[0].Format("15:04 MST"))
+	suite.Equal("01:00 EST", fires[1].Format("15:04 MST"))
+}
+
+func (suite *DSTSuite) TestFixedIntervalAcrossSpringForward() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// a daily schedule built on a fixed interval drifts from the wall clock
+	fc := suite.newClock("2024-03-09 00:30:00")
+	ticker := fc.NewTicker(24 * time.Hour)
+	defer ticker.Stop()
+
+	fires, err := ExpectFires(ctx, fc, ticker.C(), suite.date(time.March, 12, 0, 0), 2)
+	suite.Require().NoError(err)
+	suite.Equal("2024-03-10 00:30", fires[0].Format("2006-01-02 15:04"))
+	suite.Equal("2024-03-11 01:30", fires[1].Format("2006-01-02 15:04"))
+
+	_, err = ExpectFires(ctx, fc, ticker.C(), suite.date(time.March, 13, 0, 0), 2)
+	suite.ErrorContains(err, "expected 2 fires")
+}
+
+func (suite *DSTSuite) TestFiresCanceled() {
+	fc := suite.newFakeClock()
+	fc.AfterFunc(time.Second, func() {})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	fires, err := Fires(ctx, fc, make(chan time.Time), fc.Now().Add(time.Minute))
+	suite.ErrorIs(err, context.DeadlineExceeded)
+	suite.Empty(fires)
+}
+
+func TestDST(t *testing.T) {
+	suite.Run(t, new(DSTSuite))
+}
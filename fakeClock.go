@@ -4,6 +4,8 @@
 package chronon
 
 import (
+	"context"
+	"math"
 	"sync"
 	"time"
 )
@@ -12,45 +14,181 @@ import (
 // the clock is updated.  The Add and Set methods control a FakeClock's
 // notion of the current time in addition to affecting the various objects
 // created through this clock, e.g. Timer.
+//
+// A FakeClock tracks wall time separately from monotonic time, in the same way
+// that the time package does.  Add moves both forward, while StepWall moves only
+// the wall time.  Since times returned by Now carry the fake monotonic reading,
+// Sub, Since, and Until ignore wall clock steps exactly as they do in production.
+// Timers, tickers, and sleepers also follow the monotonic time.  Use TracksMonotonic
+// to verify that a FakeClock does so.
 type FakeClock struct {
 	lock sync.RWMutex
 
-	now       time.Time
-	listeners listeners
-	onSleeper notifiers
-	onTimer   notifiers
-	onTicker  notifiers
+	now        time.Time
+	skew       time.Duration
+	drift      float64
+	driftErr   float64 // the fractional nanoseconds of drift not yet applied
+	loc        *time.Location
+	leap       *LeapSecond
+	leapOffset time.Duration // the current leap second adjustment to wall time
+	listeners  listeners
+	callbacks  []func()
+	onSleeper  notifiers
+	onTimer    notifiers
+	onTicker   notifiers
+
+	// changed is closed and cleared whenever this clock's lock is released
+	// through unlock.  This allows goroutines to wait for timers, tickers,
+	// or sleepers to be created.
+	changed chan struct{}
 }
 
-var _ Clock = (*FakeClock)(nil)
+var _ ClockAt = (*FakeClock)(nil)
 
 // NewFakeClock creates a FakeClock that uses the given time as the
 // initial current time.
-func NewFakeClock(start time.Time) *FakeClock {
-	return &FakeClock{
+//
+// Monotonic time is only tracked if start carries a monotonic clock reading,
+// as is the case with time.Now().  Otherwise, this clock's times have no
+// monotonic reading and all comparisons use wall time.  TracksMonotonic reports
+// which is the case.
+func NewFakeClock(start time.Time, opts ...FakeClockOption) *FakeClock {
+	fc := &FakeClock{
 		now: start,
 	}
+
+	for _, o := range opts {
+		o(fc)
+	}
+
+	if fc.skew != 0 {
+		fc.now = withWall(start.Round(0).Add(fc.skew), start)
+	}
+
+	if fc.loc != nil {
+		fc.now = withWall(fc.now.In(fc.loc), fc.now)
+	}
+
+	if fc.leap != nil {
+		fc.leapOffset = fc.leap.offset(fc.now.Round(0))
+	}
+
+	return fc
+}
+
+// NewFakeClockInZone creates a FakeClock whose current time is the given wall time,
+// in time.DateTime format, in the named IANA time zone.  This is convenient for
+// reproducing issues reported in a particular local time, for example:
+//
+//	fc, err := NewFakeClockInZone("America/New_York", "2024-03-10 01:59:00")
+//
+// The returned clock reports its times in that zone, as with WithLocation, and it tracks
+// monotonic time.  If the zone cannot be loaded or the wall time cannot be parsed, this
+// function returns an error.
+func NewFakeClockInZone(zone, wall string, opts ...FakeClockOption) (*FakeClock, error) {
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := time.ParseInLocation(time.DateTime, wall, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	// borrow a monotonic reading, so that wall steps can be simulated
+	start = withWall(start, time.Now())
+	return NewFakeClock(start, append(opts, WithLocation(loc))...), nil
+}
+
+// localDuration converts a duration on the reference timeline into the amount
+// of time that passes on this clock, accounting for drift.  This method must be
+// invoked under this clock's lock.
+func (fc *FakeClock) localDuration(d time.Duration) time.Duration {
+	if fc.drift == 0 {
+		return d
+	}
+
+	fc.driftErr += float64(d) * fc.drift
+	adjust := time.Duration(fc.driftErr)
+	fc.driftErr -= float64(adjust)
+	return d + adjust
+}
+
+// referenceDuration is the inverse of localDuration.  It returns the smallest duration
+// on the reference timeline that moves this clock by at least the given local duration.
+// This method must be invoked under this clock's lock.
+func (fc *FakeClock) referenceDuration(d time.Duration) time.Duration {
+	if fc.drift == 0 || d <= 0 {
+		return d
+	}
+
+	return time.Duration(math.Ceil(float64(d) / (1 + fc.drift)))
+}
+
+// next returns the earliest time at which any active timer, ticker, or sleeper
+// created through this clock will fire.  If there are no such objects, this method
+// returns false.  This method must be invoked under this clock's lock.
+func (fc *FakeClock) next() (next time.Time, ok bool) {
+	for l := range fc.listeners {
+		if ss, isSnapshotter := l.(snapshotter); isSnapshotter {
+			if when := ss.snapshot().when; !ok || when.Before(next) {
+				next, ok = when, true
+			}
+		}
+	}
+
+	return
 }
 
 // doWith executes a function under this clock's lock.  The supplied
 // function is passed the current fake clock time and the set of listeners.
 func (fc *FakeClock) doWith(f func(time.Time, *listeners)) {
 	fc.lock.Lock()
-	defer fc.lock.Unlock()
+	defer fc.unlock()
 	f(fc.now, &fc.listeners)
 }
 
+// schedule queues a function to run once this clock's lock is released.  This
+// allows AfterFunc callbacks to use this clock without deadlocking.  This method
+// must be invoked under this clock's lock.
+func (fc *FakeClock) schedule(f func()) {
+	fc.callbacks = append(fc.callbacks, f)
+}
+
+// unlock releases this clock's lock, waking any goroutines waiting for this clock to
+// change, then invokes any functions queued with schedule in the order they were
+// queued.  The functions run on the calling goroutine, so they have completed by
+// the time the method that triggered them returns.
+func (fc *FakeClock) unlock() {
+	callbacks := fc.callbacks
+	fc.callbacks = nil
+	if fc.changed != nil {
+		close(fc.changed)
+		fc.changed = nil
+	}
+
+	fc.lock.Unlock()
+
+	for _, f := range callbacks {
+		f()
+	}
+}
+
 // Add updates this fake clock's current time.  The duration can be nonpositive,
 // in which case the clock moves backwards or is unaffected.
 //
 // Anytime a FakeClock's current time changes via this method or Set, any objects
 // created through this clock are updated as appropriate.
+//
+// If this clock was created with WithDrift, the duration is measured on the reference
+// timeline and this clock's time moves by the drift-adjusted amount.
 func (fc *FakeClock) Add(d time.Duration) (now time.Time) {
 	fc.lock.Lock()
-	now = fc.now.Add(d)
+	now = fc.applyLeap(fc.now.Add(fc.localDuration(d)))
 	fc.now = now
 	fc.listeners.onUpdate(now)
-	fc.lock.Unlock()
+	fc.unlock()
 
 	return
 }
@@ -59,11 +197,68 @@ func (fc *FakeClock) Add(d time.Duration) (now time.Time) {
 // of moving this fake clock's time by a certain delta.
 //
 // A common use case is to force the firing of an object by passing its When value.
+//
+// If t carries a monotonic clock reading, such as a value returned by Now or When,
+// both the wall and monotonic time are set from t.  Otherwise, the wall time is set
+// to t and the monotonic time moves by the same amount.
+//
+// If this clock was created with WithLocation, t is converted to that location.
 func (fc *FakeClock) Set(t time.Time) {
 	fc.lock.Lock()
+	if fc.leap != nil && !hasMonotonicReading(t) {
+		// t is on the continuous timeline, so express it in the current wall time
+		t = t.Add(fc.leapOffset)
+	}
+
+	t = fc.applyLeap(fc.normalize(t))
 	fc.now = t
 	fc.listeners.onUpdate(t)
-	fc.lock.Unlock()
+	fc.unlock()
+}
+
+// normalize prepares a time to become this clock's current time.  The time is put in
+// this clock's location, if any.  If this clock tracks monotonic time and t has no monotonic
+// reading, t is given one consistent with this clock's.  This method must be invoked under
+// this clock's lock.
+func (fc *FakeClock) normalize(t time.Time) time.Time {
+	if fc.loc != nil {
+		t = withWall(t.In(fc.loc), t)
+	}
+
+	if !hasMonotonicReading(t) && hasMonotonicReading(fc.now) {
+		t = withWall(t, fc.now.Add(t.Sub(fc.now.Round(0))))
+	}
+
+	return t
+}
+
+// TracksMonotonic tests if this fake clock tracks monotonic time separately from wall time.
+// This is the case when the clock was started with a time carrying a monotonic clock reading,
+// such as a value returned by time.Now, and when the running version of the time package is
+// one that this package knows how to give synthetic monotonic readings.  Otherwise, the
+// monotonic readings are silently dropped and StepWall behaves like Add.  Tests which
+// depend on wall clock steps should check this method and fail or skip if it returns false.
+func (fc *FakeClock) TracksMonotonic() bool {
+	fc.lock.RLock()
+	defer fc.lock.RUnlock()
+	return monotonicSupported && hasMonotonicReading(fc.now)
+}
+
+// StepWall moves this fake clock's wall time by the given duration without
+// affecting its monotonic time.  This simulates a wall clock jump, such as an
+// NTP step or a manual change to the system time.  The new current time is returned.
+//
+// Since timers, tickers, and sleepers follow monotonic time, they are not
+// triggered by a wall clock step.  If this clock does not track monotonic time,
+// as reported by TracksMonotonic, this method is equivalent to Add.
+func (fc *FakeClock) StepWall(d time.Duration) (now time.Time) {
+	fc.lock.Lock()
+	now = withWall(fc.now.Round(0).Add(d), fc.now)
+	fc.now = now
+	fc.listeners.onUpdate(now)
+	fc.unlock()
+
+	return
 }
 
 // Now returns the value for the current time.
@@ -100,8 +295,67 @@ func (fc *FakeClock) Until(t time.Time) (d time.Duration) {
 // If d is positive, then any channel registered with NotifyOnSleep
 // will receive d prior to blocking.
 func (fc *FakeClock) Sleep(d time.Duration) {
+	fc.startSleep(after(d)).wait()
+}
+
+// SleepUntil is like Sleep, except that it blocks until this clock reaches
+// the given time.  The Sleeper's When is exactly t.
+func (fc *FakeClock) SleepUntil(t time.Time) {
+	fc.startSleep(at(t)).wait()
+}
+
+// SleepContext is like Sleep, except that the sleep is interrupted if the context
+// is canceled.  The Sleeper dispatched to channels registered with NotifyOnSleep is
+// awakened and removed from this clock when the context is canceled.
+//
+// This method returns nil if the sleep completed, either because this clock was
+// advanced or because the Sleeper was awakened.  If the context was canceled first,
+// this method returns ctx.Err().  If the context is already canceled, this method
+// immediately returns ctx.Err() and no Sleeper is dispatched.
+func (fc *FakeClock) SleepContext(ctx context.Context, d time.Duration) error {
+	return fc.sleepContext(ctx, after(d))
+}
+
+// sleepContext implements context-aware sleeping for both relative and absolute wakeup times.
+func (fc *FakeClock) sleepContext(ctx context.Context, when func(time.Time) time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s := fc.startSleep(when)
+	select {
+	case <-s.awaken:
+		return nil
+
+	case <-ctx.Done():
+		if s.Wakeup() {
+			return ctx.Err()
+		}
+
+		// the sleep completed just before the context was canceled
+		return nil
+	}
+}
+
+// after returns a function that computes a wakeup time relative to a clock's current time.
+func after(d time.Duration) func(time.Time) time.Time {
+	return func(now time.Time) time.Time {
+		return now.Add(d)
+	}
+}
+
+// at returns a function that always returns an absolute wakeup time.
+func at(t time.Time) func(time.Time) time.Time {
+	return func(time.Time) time.Time {
+		return t
+	}
+}
+
+// startSleep creates and registers a sleeper.  The when function computes the
+// wakeup time from this clock's current time.
+func (fc *FakeClock) startSleep(when func(time.Time) time.Time) *sleeper {
 	fc.lock.Lock()
-	sleeper := newSleeperAt(fc, fc.now.Add(d))
+	sleeper := newSleeperAt(fc, when(fc.now))
 
 	// if the duration was nonpositive, the sleeper will immediately
 	// close its channel and won't be added as a listener.  This makes
@@ -110,9 +364,9 @@ func (fc *FakeClock) Sleep(d time.Duration) {
 	fc.listeners.register(fc.now, sleeper)
 
 	fc.onSleeper.notify(sleeper)
-	fc.lock.Unlock()
+	fc.unlock()
 
-	sleeper.wait()
+	return sleeper
 }
 
 // NotifyOnSleep registers a channel that receives the intervals for any goroutine
@@ -143,13 +397,25 @@ func (fc *FakeClock) StopOnSleep(ch chan<- Sleeper) {
 //
 // The Timer returned by this method can always be cast to a FakeTimer.
 func (fc *FakeClock) NewTimer(d time.Duration) Timer {
+	return fc.newTimer(after(d))
+}
+
+// NewTimerAt is like NewTimer, except that the returned Timer fires when this
+// FakeClock reaches the given time.  The timer's When is exactly t.
+func (fc *FakeClock) NewTimerAt(t time.Time) Timer {
+	return fc.newTimer(at(t))
+}
+
+// newTimer creates and registers a channel-based timer.  The when function
+// computes the timer's When from this clock's current time.
+func (fc *FakeClock) newTimer(when func(time.Time) time.Time) Timer {
 	fc.lock.Lock()
-	ft := newFakeTimer(fc, fc.now.Add(d))
+	ft := newFakeTimer(fc, when(fc.now))
 
 	fc.listeners.register(fc.now, ft)
 	fc.onTimer.notify(ft)
 
-	fc.lock.Unlock()
+	fc.unlock()
 	return ft
 }
 
@@ -158,6 +424,12 @@ func (fc *FakeClock) After(d time.Duration) <-chan time.Time {
 	return fc.NewTimer(d).C()
 }
 
+// AfterAt returns a channel which receives a time once this FakeClock
+// reaches the given time.
+func (fc *FakeClock) AfterAt(t time.Time) <-chan time.Time {
+	return fc.NewTimerAt(t).C()
+}
+
 // AfterFunc schedules a function to execute after this FakeClock has been advanced
 // by at least the given duration.  The returned Timer can be used to cancel the
 // execution, as with time.AfterFunc.  The returned Timer from this method is
@@ -165,13 +437,25 @@ func (fc *FakeClock) After(d time.Duration) <-chan time.Time {
 //
 // The Timer returned by this method can always be cast to a FakeTimer.
 func (fc *FakeClock) AfterFunc(d time.Duration, f func()) Timer {
+	return fc.afterFunc(after(d), f)
+}
+
+// AfterFuncAt is like AfterFunc, except that the function executes once this
+// FakeClock reaches the given time.  The timer's When is exactly t.
+func (fc *FakeClock) AfterFuncAt(t time.Time, f func()) Timer {
+	return fc.afterFunc(at(t), f)
+}
+
+// afterFunc creates and registers a function-based timer.  The when function
+// computes the timer's When from this clock's current time.
+func (fc *FakeClock) afterFunc(when func(time.Time) time.Time, f func()) Timer {
 	fc.lock.Lock()
-	ft := newAfterFunc(fc, fc.now.Add(d), func(time.Time) { f() })
+	ft := newAfterFunc(fc, when(fc.now), func(time.Time) { f() })
 
 	fc.listeners.register(fc.now, ft)
 	fc.onTimer.notify(ft)
 
-	fc.lock.Unlock()
+	fc.unlock()
 	return ft
 }
 
@@ -204,7 +488,7 @@ func (fc *FakeClock) NewTicker(d time.Duration) Ticker {
 
 	fc.listeners.register(fc.now, ft)
 	fc.onTicker.notify(ft)
-	fc.lock.Unlock()
+	fc.unlock()
 	return ft
 }
 
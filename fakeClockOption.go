@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import "time"
+
+// FakeClockOption represents a configurable option for a FakeClock.
+type FakeClockOption func(*FakeClock)
+
+// WithSkew offsets a FakeClock's wall time from its start time by a fixed amount.
+// A positive skew means the clock runs ahead, while a negative skew means it
+// runs behind.  Monotonic time is not affected, so durations measured with the
+// clock are the same regardless of skew.
+func WithSkew(d time.Duration) FakeClockOption {
+	return func(fc *FakeClock) {
+		fc.skew = d
+	}
+}
+
+// WithDrift sets the rate, in parts per million, at which a FakeClock gains or
+// loses time.  A positive rate means the clock runs fast, while a negative rate
+// means it runs slow.  For example, with a drift of 50, Add(time.Second) advances
+// the clock by 1.00005 seconds.
+//
+// With drift, the durations passed to Add are measured on a shared reference
+// timeline.  Timers, tickers, and sleepers fire according to the clock's drifting
+// local time.  This allows several clocks advanced by the same amounts to disagree
+// in the same way that real clocks do.
+func WithDrift(ppm float64) FakeClockOption {
+	return func(fc *FakeClock) {
+		fc.drift = ppm / 1e6
+	}
+}
+
+// WithLocation sets the location of a FakeClock's times.  The start time is converted
+// to the given location, as are times passed to Set, so that every time the clock
+// reports, including the times sent by its timers and tickers, is in that location.
+// This allows code to be tested as though it were running in a particular time zone.
+func WithLocation(loc *time.Location) FakeClockOption {
+	return func(fc *FakeClock) {
+		fc.loc = loc
+	}
+}
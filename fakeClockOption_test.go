@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type FakeClockOptionSuite struct {
+	ChrononSuite
+}
+
+func (suite *FakeClockOptionSuite) TestWithSkew() {
+	for _, skew := range []time.Duration{-time.Minute, time.Minute} {
+		suite.Run(skew.String(), func() {
+			fc := NewFakeClock(suite.now, WithSkew(skew))
+			suite.Equal(skew, fc.Now().Round(0).Sub(suite.now.Round(0)))
+
+			// monotonic time is unaffected by skew
+			suite.Zero(fc.Since(suite.now))
+
+			t := fc.NewTimer(TestInterval)
+			fc.Add(TestInterval)
+			suite.requireSignal(t.C(), Immediate)
+			suite.Equal(skew+TestInterval, fc.Now().Round(0).Sub(suite.now.Round(0)))
+		})
+	}
+}
+
+func (suite *FakeClockOptionSuite) TestWithDrift() {
+	suite.Run("Fast", func() {
+		fc := NewFakeClock(suite.now, WithDrift(50))
+		suite.Equal(suite.now, fc.Now())
+
+		fc.Add(time.Second)
+		suite.Equal(time.Second+50*time.Microsecond, fc.Since(suite.now))
+
+		// fractional nanoseconds accumulate rather than being lost
+		for i := 0; i < 1000; i++ {
+			fc.Add(time.Microsecond)
+		}
+
+		suite.Equal(time.Second+time.Millisecond+50*time.Microsecond+50*time.Nanosecond, fc.Since(suite.now))
+	})
+
+	suite.Run("Slow", func() {
+		fc := NewFakeClock(suite.now, WithDrift(-100))
+		t := fc.NewTimer(time.Second)
+
+		// the timer follows local time, so a reference second isn't enough
+		fc.Add(time.Second)
+		suite.requireNoSignal(t.C(), Immediate)
+		suite.Equal(time.Second-100*time.Microsecond, fc.Since(suite.now))
+
+		fc.Add(101 * time.Microsecond)
+		suite.requireSignal(t.C(), Immediate)
+	})
+}
+
+func TestFakeClockOption(t *testing.T) {
+	suite.Run(t, new(FakeClockOptionSuite))
+}
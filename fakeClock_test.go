@@ -34,6 +34,52 @@ func (suite *FakeClockSuite) TestAdd() {
 	suite.Equal(newNow.Add(-650*time.Minute), fc.Now())
 }
 
+func (suite *FakeClockSuite) TestSetWithoutMonotonic() {
+	fc := suite.newFakeClock()
+	wall := suite.now.Round(0).Add(time.Hour)
+
+	fc.Set(wall)
+	suite.True(wall.Equal(fc.Now()))
+	suite.Equal(time.Hour, fc.Since(suite.now))
+	suite.Equal(time.Hour, fc.Now().Sub(suite.now))
+}
+
+func (suite *FakeClockSuite) TestStepWall() {
+	suite.Run("Monotonic", func() {
+		fc := suite.newFakeClock()
+		suite.Require().True(fc.TracksMonotonic())
+		t := fc.NewTimer(TestInterval)
+
+		now := fc.StepWall(time.Hour)
+		suite.Equal(now, fc.Now())
+		suite.Equal(time.Hour, now.Round(0).Sub(suite.now.Round(0)))
+		suite.Zero(now.Sub(suite.now))
+		suite.Zero(fc.Since(suite.now))
+		suite.requireNoSignal(t.C(), Immediate)
+
+		now = fc.StepWall(-2 * time.Hour)
+		suite.Equal(-time.Hour, now.Round(0).Sub(suite.now.Round(0)))
+		suite.Zero(fc.Since(suite.now))
+
+		now = fc.Add(TestInterval)
+		suite.Equal(TestInterval-time.Hour, now.Round(0).Sub(suite.now.Round(0)))
+		suite.Equal(TestInterval, fc.Since(suite.now))
+		suite.requireSignal(t.C(), Immediate)
+	})
+
+	suite.Run("WallOnly", func() {
+		start := suite.now.Round(0)
+		fc := NewFakeClock(start)
+		suite.Require().False(fc.TracksMonotonic())
+		t := fc.NewTimer(TestInterval)
+
+		now := fc.StepWall(time.Hour)
+		suite.Equal(start.Add(time.Hour), now)
+		suite.Equal(time.Hour, fc.Since(start))
+		suite.requireSignal(t.C(), Immediate)
+	})
+}
+
 func (suite *FakeClockSuite) TestSleep() {
 	for _, interval := range []time.Duration{-TestInterval, 0, TestInterval} {
 		suite.Run(interval.String(), func() {
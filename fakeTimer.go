@@ -84,11 +84,15 @@ func (ft *fakeTimer) Fire() (fired bool) {
 // fire handles dispatching the time event appropriately.  Depending
 // upon how this timer was created, this will be either sending the
 // time on a channel or invoking an arbitrary function.
+//
+// Functions are not invoked under the containing FakeClock's lock.  Instead, they
+// are invoked after the lock is released, so that they can safely use the clock.
 func (ft *fakeTimer) fire(t time.Time) {
 	if ft.c != nil {
 		sendTime(ft.c, t)
 	} else {
-		ft.f(t)
+		f := ft.f
+		ft.fc.schedule(func() { f(t) })
 	}
 }
 
@@ -141,6 +141,18 @@ func (suite *FakeTimerSuite) TestAfterFunc() {
 		suite.False(t.Fire())
 	})
 
+	suite.Run("UsesClock", func() {
+		fc := suite.newFakeClock()
+		called := make(chan time.Time, 1)
+		fc.AfterFunc(TestInterval, func() {
+			// the callback must be able to use the clock without deadlocking
+			called <- fc.Now()
+		})
+
+		now := fc.Add(TestInterval)
+		suite.requireReceiveEqual(called, now, Immediate)
+	})
+
 	suite.Run("StopReset", func() {
 		t, fc, called := suite.newAfterFunc(TestInterval)
 
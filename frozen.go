@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// frozenClock is a Clock whose time never moves.
+type frozenClock struct {
+	now time.Time
+}
+
+// Frozen returns a read-only Clock whose time is always t.  Since time never moves,
+// timers and tickers with positive durations never fire, and Sleep with a positive
+// duration blocks forever.  As with a *FakeClock, timers with nonpositive durations
+// fire immediately.
+//
+// Use a *FakeClock instead when a test needs to move time.
+func Frozen(t time.Time) Clock {
+	return frozenClock{now: t}
+}
+
+func (fc frozenClock) Now() time.Time {
+	return fc.now
+}
+
+func (fc frozenClock) Since(t time.Time) time.Duration {
+	return fc.now.Sub(t)
+}
+
+func (fc frozenClock) Until(t time.Time) time.Duration {
+	return t.Sub(fc.now)
+}
+
+func (fc frozenClock) Sleep(d time.Duration) {
+	if d > 0 {
+		select {}
+	}
+}
+
+func (fc frozenClock) After(d time.Duration) <-chan time.Time {
+	return fc.NewTimer(d).C()
+}
+
+func (fc frozenClock) AfterFunc(d time.Duration, f func()) Timer {
+	ft := &frozenTimer{
+		now: fc.now,
+		f:   f,
+	}
+
+	ft.Reset(d)
+	return ft
+}
+
+func (fc frozenClock) Tick(d time.Duration) <-chan time.Time {
+	if d <= 0 {
+		// consistent with time.Tick
+		return nil
+	}
+
+	return fc.NewTicker(d).C()
+}
+
+func (fc frozenClock) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		// consistent with time.NewTicker
+		panic(errors.New("non-positive interval for NewTicker"))
+	}
+
+	return frozenTicker{
+		c: make(chan time.Time, 1),
+	}
+}
+
+func (fc frozenClock) NewTimer(d time.Duration) Timer {
+	ft := &frozenTimer{
+		now: fc.now,
+		c:   make(chan time.Time, 1),
+	}
+
+	ft.Reset(d)
+	return ft
+}
+
+// frozenTimer is a Timer created through a frozen clock.  It fires only if
+// it is started with a nonpositive duration.
+type frozenTimer struct {
+	now time.Time
+	c   chan time.Time
+	f   func()
+
+	lock   sync.Mutex
+	active bool
+}
+
+func (ft *frozenTimer) C() <-chan time.Time {
+	return ft.c
+}
+
+func (ft *frozenTimer) Reset(d time.Duration) bool {
+	ft.lock.Lock()
+	active := ft.active
+	ft.active = d > 0
+	ft.lock.Unlock()
+
+	if d <= 0 {
+		if ft.c != nil {
+			sendTime(ft.c, ft.now)
+		} else {
+			ft.f()
+		}
+	}
+
+	return active
+}
+
+func (ft *frozenTimer) Stop() (active bool) {
+	ft.lock.Lock()
+	active = ft.active
+	ft.active = false
+	ft.lock.Unlock()
+	return
+}
+
+// frozenTicker is a Ticker created through a frozen clock.  It never ticks.
+type frozenTicker struct {
+	c chan time.Time
+}
+
+func (ft frozenTicker) C() <-chan time.Time {
+	return ft.c
+}
+
+func (ft frozenTicker) Reset(d time.Duration) {
+	if d <= 0 {
+		// consistent with time.Ticker
+		panic(errors.New("non-positive interval for Ticker.Reset"))
+	}
+}
+
+func (ft frozenTicker) Stop() {}
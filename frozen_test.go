@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type FrozenSuite struct {
+	ChrononSuite
+}
+
+func (suite *FrozenSuite) TestNow() {
+	c := Frozen(suite.now)
+	suite.Equal(suite.now, c.Now())
+	suite.Equal(time.Second, c.Since(suite.now.Add(-time.Second)))
+	suite.Equal(time.Second, c.Until(suite.now.Add(time.Second)))
+
+	time.Sleep(time.Millisecond)
+	suite.Equal(suite.now, c.Now())
+}
+
+func (suite *FrozenSuite) TestTimers() {
+	c := Frozen(suite.now)
+
+	t := c.NewTimer(time.Millisecond)
+	suite.requireNoSignal(t.C(), WaitALittle)
+	suite.True(t.Reset(time.Second))
+	suite.True(t.Stop())
+	suite.False(t.Stop())
+	suite.False(t.Reset(0))
+	suite.requireReceiveEqual(t.C(), suite.now, Immediate)
+
+	suite.requireReceiveEqual(c.After(0), suite.now, Immediate)
+	suite.requireNoSignal(c.After(time.Millisecond), Immediate)
+
+	called := 0
+	f := c.AfterFunc(-time.Second, func() { called++ })
+	suite.Nil(f.C())
+	suite.Equal(1, called)
+	suite.False(f.Stop())
+	suite.False(f.Reset(time.Second))
+	suite.True(f.Stop())
+	suite.Equal(1, called)
+
+	c.Sleep(0)
+	c.Sleep(-time.Second)
+}
+
+func (suite *FrozenSuite) TestTickers() {
+	c := Frozen(suite.now)
+
+	suite.Nil(c.Tick(0))
+	suite.Panics(func() { c.NewTicker(0) })
+
+	ticker := c.NewTicker(time.Millisecond)
+	suite.requireNoSignal(ticker.C(), WaitALittle)
+	ticker.Reset(time.Second)
+	suite.Panics(func() { ticker.Reset(0) })
+	ticker.Stop()
+
+	suite.requireNoSignal(c.Tick(time.Millisecond), Immediate)
+}
+
+func TestFrozen(t *testing.T) {
+	suite.Run(t, new(FrozenSuite))
+}
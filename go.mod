@@ -2,7 +2,10 @@ module github.com/xmidt-org/chronon
 
 go 1.22
 
-require github.com/stretchr/testify v1.12.1
+require (
+	github.com/stretchr/testify v1.12.1
+	golang.org/x/sys v0.30.0
+)
 
 require (
 	github.com/stretchr/objx v0.5.3 // indirect
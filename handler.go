@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"context"
+	"log/slog"
+)
+
+// clockHandler is a slog.Handler that stamps records with a Clock's time.
+type clockHandler struct {
+	next  slog.Handler
+	clock Clock
+}
+
+// NewClockHandler decorates a slog.Handler so that each record's time comes from a Clock
+// rather than time.Now.  When the clock is a *FakeClock, log timestamps line up with fake
+// time, which makes log output deterministic in tests.
+//
+// If clock is nil, each record is stamped with the Clock associated with the context
+// passed to Handle.  See Get.  Records with a zero time are left alone, since handlers
+// omit the time for such records.
+func NewClockHandler(next slog.Handler, clock Clock) slog.Handler {
+	return &clockHandler{
+		next:  next,
+		clock: clock,
+	}
+}
+
+func (ch *clockHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return ch.next.Enabled(ctx, level)
+}
+
+func (ch *clockHandler) Handle(ctx context.Context, r slog.Record) error {
+	if !r.Time.IsZero() {
+		clock := ch.clock
+		if clock == nil {
+			clock = Get(ctx)
+		}
+
+		r.Time = clock.Now()
+	}
+
+	return ch.next.Handle(ctx, r)
+}
+
+func (ch *clockHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &clockHandler{
+		next:  ch.next.WithAttrs(attrs),
+		clock: ch.clock,
+	}
+}
+
+func (ch *clockHandler) WithGroup(name string) slog.Handler {
+	return &clockHandler{
+		next:  ch.next.WithGroup(name),
+		clock: ch.clock,
+	}
+}
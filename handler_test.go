@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ClockHandlerSuite struct {
+	ChrononSuite
+
+	output bytes.Buffer
+}
+
+func (suite *ClockHandlerSuite) SetupTest() {
+	suite.output.Reset()
+}
+
+func (suite *ClockHandlerSuite) newLogger(clock Clock) *slog.Logger {
+	h := NewClockHandler(slog.NewTextHandler(&suite.output, nil), clock)
+	suite.Require().NotNil(h)
+	return slog.New(h)
+}
+
+func (suite *ClockHandlerSuite) TestClock() {
+	fc := NewFakeClock(time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC))
+	logger := suite.newLogger(fc).With("service", "test").WithGroup("request")
+	logger.Info("hello", "id", 1)
+	fc.Add(time.Second)
+	logger.Debug("disabled")
+	logger.Info("world")
+
+	suite.Equal(
+		"time=2024-03-01T12:00:00.000Z level=INFO msg=hello service=test request.id=1\n"+
+			"time=2024-03-01T12:00:01.000Z level=INFO msg=world service=test\n",
+		suite.output.String(),
+	)
+}
+
+func (suite *ClockHandlerSuite) TestContext() {
+	fc := NewFakeClock(time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC))
+	logger := suite.newLogger(nil)
+	logger.InfoContext(With(context.Background(), fc), "hello")
+
+	suite.Equal("time=2024-03-01T12:00:00.000Z level=INFO msg=hello\n", suite.output.String())
+}
+
+func (suite *ClockHandlerSuite) TestZeroTime() {
+	h := NewClockHandler(slog.NewTextHandler(&suite.output, nil), suite.newFakeClock())
+	suite.Require().NoError(h.Handle(context.Background(), slog.NewRecord(time.Time{}, slog.LevelInfo, "hello", 0)))
+	suite.Equal("level=INFO msg=hello\n", suite.output.String())
+}
+
+func TestClockHandler(t *testing.T) {
+	suite.Run(t, new(ClockHandlerSuite))
+}
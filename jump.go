@@ -0,0 +1,254 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultJumpInterval is the interval at which a JumpMonitor checks its clock
+	// when none is configured.
+	DefaultJumpInterval = time.Second
+
+	// DefaultJumpTolerance is the amount by which wall time and monotonic time may
+	// disagree before a JumpMonitor reports a jump, when none is configured.
+	DefaultJumpTolerance = 100 * time.Millisecond
+
+	// DefaultSuspendThreshold is the amount by which a check may be late before a
+	// JumpMonitor reports a suspend, when none is configured.
+	DefaultSuspendThreshold = 5 * time.Second
+)
+
+// JumpKind describes a discontinuity detected by a JumpMonitor.
+type JumpKind string
+
+const (
+	// JumpForward indicates that the wall clock moved ahead of monotonic time, as with
+	// an NTP step forward or a manual change to the system time.  On Linux, monotonic
+	// time does not advance while the system is suspended, so resuming from a suspend
+	// is also reported as a forward jump.
+	JumpForward JumpKind = "forward"
+
+	// JumpBackward indicates that the wall clock moved behind monotonic time, as with
+	// an NTP step backward or a manual change to the system time.
+	JumpBackward JumpKind = "backward"
+
+	// JumpSuspend indicates that far more monotonic time elapsed between checks than
+	// expected.  The process did not run for a while, for example because its VM was
+	// paused or the system was suspended on a platform whose monotonic time includes
+	// suspends.
+	JumpSuspend JumpKind = "suspend"
+)
+
+// JumpEvent describes a discontinuity detected between two consecutive checks of a clock.
+type JumpEvent struct {
+	// Kind is the type of discontinuity.
+	Kind JumpKind `json:"kind"`
+
+	// Previous is the clock time at the previous check.
+	Previous time.Time `json:"previous"`
+
+	// Detected is the clock time at which the discontinuity was detected.
+	Detected time.Time `json:"detected"`
+
+	// Wall is the wall clock time that elapsed between the checks.
+	Wall time.Duration `json:"wall"`
+
+	// Monotonic is the monotonic time that elapsed between the checks.
+	Monotonic time.Duration `json:"monotonic"`
+}
+
+// Skew is the amount by which the wall clock moved relative to monotonic time.  This
+// value is positive for forward jumps and negative for backward jumps.
+func (je JumpEvent) Skew() time.Duration {
+	return je.Wall - je.Monotonic
+}
+
+// JumpSubscriber receives the events detected by a JumpMonitor.
+type JumpSubscriber interface {
+	OnJump(JumpEvent)
+}
+
+// JumpSubscriberFunc is a function type that implements JumpSubscriber.
+type JumpSubscriberFunc func(JumpEvent)
+
+func (f JumpSubscriberFunc) OnJump(je JumpEvent) {
+	f(je)
+}
+
+// JumpMonitorOption represents a configurable option for a JumpMonitor.
+type JumpMonitorOption func(*JumpMonitor)
+
+// WithJumpInterval sets the interval at which Run checks the clock.  Nonpositive
+// values are ignored.
+func WithJumpInterval(d time.Duration) JumpMonitorOption {
+	return func(jm *JumpMonitor) {
+		if d > 0 {
+			jm.interval = d
+		}
+	}
+}
+
+// WithJumpTolerance sets the amount by which wall time and monotonic time may disagree
+// between checks before a jump is reported.  Negative values are ignored.
+func WithJumpTolerance(d time.Duration) JumpMonitorOption {
+	return func(jm *JumpMonitor) {
+		if d >= 0 {
+			jm.tolerance = d
+		}
+	}
+}
+
+// WithSuspendThreshold sets the amount by which the monotonic time between checks may
+// exceed the interval before a suspend is reported.  Nonpositive values are ignored.
+func WithSuspendThreshold(d time.Duration) JumpMonitorOption {
+	return func(jm *JumpMonitor) {
+		if d > 0 {
+			jm.suspend = d
+		}
+	}
+}
+
+// JumpMonitor detects wall clock jumps and suspends by periodically comparing the wall
+// clock time that elapsed since its last check with the monotonic time that elapsed.
+// Subscribers are notified of each discontinuity, which allows services to rearm
+// schedules or refresh leases.
+//
+// Detection requires times with monotonic clock readings, such as those returned by
+// SystemClock() or by a *FakeClock created with a monotonic start time.  With a FakeClock,
+// StepWall simulates a jump and Add simulates a suspend.
+type JumpMonitor struct {
+	clock     Clock
+	interval  time.Duration
+	tolerance time.Duration
+	suspend   time.Duration
+
+	lock        sync.Mutex
+	last        time.Time
+	nextID      uint64
+	subscribers map[uint64]JumpSubscriber
+}
+
+// NewJumpMonitor creates a JumpMonitor for the given Clock.  If c is nil, SystemClock()
+// is used.  Check must be called periodically, typically by running Run in its own goroutine.
+func NewJumpMonitor(c Clock, opts ...JumpMonitorOption) *JumpMonitor {
+	if c == nil {
+		c = SystemClock()
+	}
+
+	jm := &JumpMonitor{
+		clock:       c,
+		interval:    DefaultJumpInterval,
+		tolerance:   DefaultJumpTolerance,
+		suspend:     DefaultSuspendThreshold,
+		subscribers: make(map[uint64]JumpSubscriber),
+	}
+
+	for _, o := range opts {
+		o(jm)
+	}
+
+	return jm
+}
+
+// Subscribe adds a subscriber that receives each detected event.  Subscribers are invoked
+// synchronously by Check, so they should not block.  The returned function removes the
+// subscriber, and it is idempotent.
+func (jm *JumpMonitor) Subscribe(s JumpSubscriber) (cancel func()) {
+	jm.lock.Lock()
+	id := jm.nextID
+	jm.nextID++
+	jm.subscribers[id] = s
+	jm.lock.Unlock()
+
+	return func() {
+		jm.lock.Lock()
+		delete(jm.subscribers, id)
+		jm.lock.Unlock()
+	}
+}
+
+// Check compares the clock's current time with the time of the previous check, dispatching
+// any detected events to subscribers.  The detected events are returned.  The first call
+// only establishes a baseline.  Since a suspend is detected when a check is late, Check
+// should be called once per configured interval, as Run does.
+func (jm *JumpMonitor) Check() (events []JumpEvent) {
+	now := jm.clock.Now()
+
+	jm.lock.Lock()
+	last := jm.last
+	jm.last = now
+	if last.IsZero() {
+		jm.lock.Unlock()
+		return
+	}
+
+	var (
+		wall      = now.Round(0).Sub(last.Round(0))
+		monotonic = now.Sub(last)
+		skew      = wall - monotonic
+		event     = JumpEvent{
+			Previous:  last,
+			Detected:  now,
+			Wall:      wall,
+			Monotonic: monotonic,
+		}
+	)
+
+	if monotonic-jm.interval > jm.suspend {
+		event.Kind = JumpSuspend
+		events = append(events, event)
+	}
+
+	switch {
+	case skew > jm.tolerance:
+		event.Kind = JumpForward
+		events = append(events, event)
+
+	case skew < -jm.tolerance:
+		event.Kind = JumpBackward
+		events = append(events, event)
+	}
+
+	subscribers := make([]JumpSubscriber, 0, len(jm.subscribers))
+	for _, s := range jm.subscribers {
+		subscribers = append(subscribers, s)
+	}
+
+	jm.lock.Unlock()
+
+	for _, e := range events {
+		for _, s := range subscribers {
+			s.OnJump(e)
+		}
+	}
+
+	return
+}
+
+// Run calls Check at the configured interval until the context is canceled, at which
+// point this method returns the context's error.  A new baseline is established when
+// this method starts.
+func (jm *JumpMonitor) Run(ctx context.Context) error {
+	jm.lock.Lock()
+	jm.last = time.Time{}
+	jm.lock.Unlock()
+	jm.Check()
+
+	t := jm.clock.NewTicker(jm.interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-t.C():
+			jm.Check()
+		}
+	}
+}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type JumpMonitorSuite struct {
+	ChrononSuite
+}
+
+func (suite *JumpMonitorSuite) newJumpMonitor(opts ...JumpMonitorOption) (*JumpMonitor, *FakeClock) {
+	fc := suite.newFakeClock()
+	suite.Require().True(fc.TracksMonotonic())
+
+	jm := NewJumpMonitor(fc, opts...)
+	suite.Require().NotNil(jm)
+	suite.Empty(jm.Check())
+	return jm, fc
+}
+
+func (suite *JumpMonitorSuite) TestNoJump() {
+	jm, fc := suite.newJumpMonitor()
+	for i := 0; i < 3; i++ {
+		fc.Add(time.Second)
+		suite.Empty(jm.Check())
+	}
+
+	// small discrepancies are tolerated
+	fc.StepWall(50 * time.Millisecond)
+	fc.Add(time.Second)
+	suite.Empty(jm.Check())
+}
+
+func (suite *JumpMonitorSuite) TestForward() {
+	jm, fc := suite.newJumpMonitor()
+	previous := fc.Now()
+	fc.StepWall(time.Minute)
+	fc.Add(time.Second)
+
+	events := jm.Check()
+	suite.Require().Len(events, 1)
+	suite.Equal(JumpForward, events[0].Kind)
+	suite.Equal(previous, events[0].Previous)
+	suite.Equal(fc.Now(), events[0].Detected)
+	suite.Equal(time.Minute+time.Second, events[0].Wall)
+	suite.Equal(time.Second, events[0].Monotonic)
+	suite.Equal(time.Minute, events[0].Skew())
+
+	fc.Add(time.Second)
+	suite.Empty(jm.Check())
+}
+
+func (suite *JumpMonitorSuite) TestBackward() {
+	jm, fc := suite.newJumpMonitor(WithJumpTolerance(0))
+	fc.StepWall(-time.Millisecond)
+	fc.Add(time.Second)
+
+	events := jm.Check()
+	suite.Require().Len(events, 1)
+	suite.Equal(JumpBackward, events[0].Kind)
+	suite.Equal(-time.Millisecond, events[0].Skew())
+}
+
+func (suite *JumpMonitorSuite) TestSuspend() {
+	jm, fc := suite.newJumpMonitor(WithSuspendThreshold(time.Minute))
+	fc.Add(time.Minute)
+	suite.Empty(jm.Check())
+
+	fc.Add(time.Hour)
+	events := jm.Check()
+	suite.Require().Len(events, 1)
+	suite.Equal(JumpSuspend, events[0].Kind)
+	suite.Equal(time.Hour, events[0].Monotonic)
+	suite.Zero(events[0].Skew())
+
+	// a suspend and a jump can be detected together
+	fc.Add(time.Hour)
+	fc.StepWall(-time.Hour)
+	events = jm.Check()
+	suite.Require().Len(events, 2)
+	suite.Equal(JumpSuspend, events[0].Kind)
+	suite.Equal(JumpBackward, events[1].Kind)
+}
+
+func (suite *JumpMonitorSuite) TestSubscribe() {
+	jm, fc := suite.newJumpMonitor()
+
+	var first, second []JumpEvent
+	cancelFirst := jm.Subscribe(JumpSubscriberFunc(func(je JumpEvent) { first = append(first, je) }))
+	jm.Subscribe(JumpSubscriberFunc(func(je JumpEvent) { second = append(second, je) }))
+
+	fc.StepWall(time.Minute)
+	events := jm.Check()
+	suite.Len(events, 1)
+	suite.Equal(events, first)
+	suite.Equal(events, second)
+
+	cancelFirst()
+	cancelFirst()
+	fc.StepWall(-time.Minute)
+	events = jm.Check()
+	suite.Len(events, 1)
+	suite.Len(first, 1)
+	suite.Len(second, 2)
+}
+
+func (suite *JumpMonitorSuite) TestRun() {
+	var (
+		fc        = suite.newFakeClock()
+		jm        = NewJumpMonitor(fc, WithJumpInterval(time.Second))
+		jumps     = make(chan JumpEvent, 1)
+		ctx, stop = context.WithCancel(context.Background())
+		runErr    = make(chan error, 1)
+	)
+
+	defer stop()
+	waitCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	jm.Subscribe(JumpSubscriberFunc(func(je JumpEvent) { jumps <- je }))
+	go func() {
+		runErr <- jm.Run(ctx)
+	}()
+
+	suite.Require().NoError(fc.WaitForPending(waitCtx, 1))
+	fc.Add(time.Second)
+	suite.requireNoSignal(jumps, WaitALittle)
+
+	fc.StepWall(time.Hour)
+	fc.Add(time.Second)
+	je := suite.requireReceive(jumps, WaitALittle).(JumpEvent)
+	suite.Equal(JumpForward, je.Kind)
+	suite.Equal(time.Hour, je.Skew())
+
+	stop()
+	suite.ErrorIs(suite.requireReceive(runErr, WaitALittle).(error), context.Canceled)
+}
+
+func (suite *JumpMonitorSuite) TestSystemClock() {
+	jm := NewJumpMonitor(nil)
+	suite.Empty(jm.Check())
+	suite.Empty(jm.Check())
+}
+
+func TestJumpMonitor(t *testing.T) {
+	suite.Run(t, new(JumpMonitorSuite))
+}
@@ -0,0 +1,277 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"context"
+	"expvar"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// DefaultLeakThreshold is the threshold a LeakDetector uses when none is configured.
+const DefaultLeakThreshold = time.Minute
+
+// LeakReason describes why a LeakDetector flagged an object.
+type LeakReason string
+
+const (
+	// LeakTicker indicates a ticker whose channel has gone unreceived for
+	// at least the detector's threshold.
+	LeakTicker LeakReason = "unreceivedTicker"
+
+	// LeakTimer indicates a timer that fired at least the detector's threshold ago,
+	// but which was neither stopped nor drained.
+	LeakTimer LeakReason = "undrainedTimer"
+
+	// LeakTick indicates a ticker created with Tick.  Such a ticker can never be
+	// stopped, so it is always reported regardless of the threshold.
+	LeakTick LeakReason = "tick"
+)
+
+// Leak describes a site in code that leaks timers or tickers.
+type Leak struct {
+	// Reason is why the objects created at Site were flagged.
+	Reason LeakReason `json:"reason"`
+
+	// Site is where the leaking objects were created.
+	Site Site `json:"site"`
+
+	// Count is the number of live objects created at Site that exhibited
+	// the leak when it was detected.
+	Count int `json:"count"`
+
+	// Oldest is the oldest of the leaking objects.
+	Oldest Tracked `json:"oldest"`
+
+	// Detected is the clock time at which the leak was detected.
+	Detected time.Time `json:"detected"`
+}
+
+// LeakReporter receives the leaks found by a LeakDetector.  A LeakDetector reports
+// each distinct Reason and Site only once, so implementations do not need to deduplicate.
+type LeakReporter interface {
+	ReportLeak(Leak)
+}
+
+// LeakReporterFunc is a function type that implements LeakReporter.
+type LeakReporterFunc func(Leak)
+
+func (f LeakReporterFunc) ReportLeak(l Leak) {
+	f(l)
+}
+
+// slogLeakReporter logs each leak as a warning.
+type slogLeakReporter struct {
+	logger *slog.Logger
+}
+
+func (r slogLeakReporter) ReportLeak(l Leak) {
+	r.logger.Warn(
+		"leaked timer or ticker",
+		slog.String("reason", string(l.Reason)),
+		slog.String("site", l.Site.String()),
+		slog.String("function", l.Site.Function),
+		slog.Int("count", l.Count),
+		slog.String("kind", string(l.Oldest.Kind)),
+		slog.Duration("age", l.Detected.Sub(l.Oldest.Created)),
+	)
+}
+
+// NewSlogLeakReporter returns a LeakReporter that logs each leak as a warning to the
+// given logger.  If logger is nil, slog.Default() is used.
+func NewSlogLeakReporter(logger *slog.Logger) LeakReporter {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return slogLeakReporter{logger: logger}
+}
+
+// expvarLeakReporter counts leaks by reason.
+type expvarLeakReporter struct {
+	m *expvar.Map
+}
+
+func (r expvarLeakReporter) ReportLeak(l Leak) {
+	r.m.Add(string(l.Reason), 1)
+}
+
+// NewExpvarLeakReporter returns a LeakReporter that counts leaking sites in the given
+// map, keyed by LeakReason.  Typically, m is created with expvar.NewMap so that the
+// counters are published.
+func NewExpvarLeakReporter(m *expvar.Map) LeakReporter {
+	return expvarLeakReporter{m: m}
+}
+
+// leakKey is how a LeakDetector deduplicates leaks.
+type leakKey struct {
+	reason LeakReason
+	site   string
+}
+
+// LeakDetectorOption represents a configurable option for a LeakDetector.
+type LeakDetectorOption func(*LeakDetector)
+
+// WithLeakThreshold sets how long a ticker may go unreceived, or a fired timer may go
+// undrained, before it is reported.  Nonpositive values are ignored.
+func WithLeakThreshold(d time.Duration) LeakDetectorOption {
+	return func(ld *LeakDetector) {
+		if d > 0 {
+			ld.threshold = d
+		}
+	}
+}
+
+// WithLeakInterval sets how often Run checks for leaks.  By default, the interval
+// is half the threshold.  Nonpositive values are ignored.
+func WithLeakInterval(d time.Duration) LeakDetectorOption {
+	return func(ld *LeakDetector) {
+		if d > 0 {
+			ld.interval = d
+		}
+	}
+}
+
+// WithLeakReporter adds reporters that receive each leak.  If no reporters are
+// configured, leaks are logged to slog.Default().
+func WithLeakReporter(r ...LeakReporter) LeakDetectorOption {
+	return func(ld *LeakDetector) {
+		ld.reporters = append(ld.reporters, r...)
+	}
+}
+
+// LeakDetector periodically inspects the objects created through a TrackingClock and
+// reports those that appear to be leaking.  It flags tickers whose channels have not been
+// received from within a threshold, timers that fired more than a threshold ago and were
+// neither stopped nor drained, and every use of Tick.
+//
+// Leaks are deduplicated by reason and creation site, so a single leaking code path is
+// reported once no matter how many objects it leaks.
+//
+// The TrackingClock records each receive from a timer or ticker channel as it happens, so
+// detection works with any Go version's timer channel semantics.
+type LeakDetector struct {
+	tc        *TrackingClock
+	threshold time.Duration
+	interval  time.Duration
+	reporters []LeakReporter
+
+	lock     sync.Mutex
+	reported map[leakKey]bool
+}
+
+// NewLeakDetector creates a LeakDetector for the given TrackingClock.  Check must be
+// called periodically, typically by running Run in its own goroutine.
+func NewLeakDetector(tc *TrackingClock, opts ...LeakDetectorOption) *LeakDetector {
+	ld := &LeakDetector{
+		tc:        tc,
+		threshold: DefaultLeakThreshold,
+		reported:  make(map[leakKey]bool),
+	}
+
+	for _, o := range opts {
+		o(ld)
+	}
+
+	if ld.interval <= 0 {
+		ld.interval = ld.threshold / 2
+	}
+
+	if len(ld.reporters) == 0 {
+		ld.reporters = append(ld.reporters, NewSlogLeakReporter(nil))
+	}
+
+	return ld
+}
+
+// reason determines if the given entry is leaking.
+func (ld *LeakDetector) reason(now time.Time, e trackedEntry) (LeakReason, bool) {
+	switch {
+	case e.Kind == KindTick:
+		return LeakTick, true
+
+	case e.Kind == KindTicker:
+		return LeakTicker, !e.Unreceived.IsZero() && now.Sub(e.Unreceived) >= ld.threshold
+
+	case e.Fired:
+		return LeakTimer, now.Sub(e.Unreceived) >= ld.threshold
+
+	default:
+		return "", false
+	}
+}
+
+// Check inspects the TrackingClock once, dispatching any newly found leaks to the
+// configured reporters.  The newly found leaks are returned.  Leaks that were reported
+// by previous calls are not returned again.
+func (ld *LeakDetector) Check() []Leak {
+	now, entries := ld.tc.sample()
+
+	ld.lock.Lock()
+	var (
+		leaks []Leak
+		found = make(map[leakKey]int)
+	)
+
+	// entries are ordered by ID, so the first entry for a site is the oldest
+	for _, e := range entries {
+		reason, ok := ld.reason(now, e)
+		if !ok {
+			continue
+		}
+
+		key := leakKey{reason: reason, site: e.Site.String()}
+		if ld.reported[key] {
+			continue
+		}
+
+		if i, ok := found[key]; ok {
+			leaks[i].Count++
+			continue
+		}
+
+		found[key] = len(leaks)
+		leaks = append(leaks, Leak{
+			Reason:   reason,
+			Site:     e.Site,
+			Count:    1,
+			Oldest:   e.Tracked,
+			Detected: now,
+		})
+	}
+
+	for key := range found {
+		ld.reported[key] = true
+	}
+
+	ld.lock.Unlock()
+
+	for _, l := range leaks {
+		for _, r := range ld.reporters {
+			r.ReportLeak(l)
+		}
+	}
+
+	return leaks
+}
+
+// Run calls Check at the configured interval until the context is canceled, at which
+// point this method returns the context's error.  The interval is driven by the
+// TrackingClock's base clock, so the detector's own ticker is never reported.
+func (ld *LeakDetector) Run(ctx context.Context) error {
+	t := ld.tc.base.NewTicker(ld.interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-t.C():
+			ld.Check()
+		}
+	}
+}
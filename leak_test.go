@@ -0,0 +1,261 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+// leak detection must not depend on timer channel semantics, so these
+// tests use the semantics introduced in Go 1.23
+//go:debug asynctimerchan=0
+
+package chronon
+
+import (
+	"bytes"
+	"context"
+	"expvar"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LeakDetectorSuite struct {
+	ChrononSuite
+
+	fc       *FakeClock
+	tc       *TrackingClock
+	reported []Leak
+}
+
+func (suite *LeakDetectorSuite) SetupTest() {
+	suite.fc = suite.newFakeClock()
+	suite.tc = NewTrackingClock(suite.fc)
+	suite.reported = nil
+}
+
+func (suite *LeakDetectorSuite) newLeakDetector(opts ...LeakDetectorOption) *LeakDetector {
+	opts = append(
+		[]LeakDetectorOption{
+			WithLeakThreshold(time.Minute),
+			WithLeakReporter(LeakReporterFunc(func(l Leak) {
+				suite.reported = append(suite.reported, l)
+			})),
+		},
+		opts...,
+	)
+
+	ld := NewLeakDetector(suite.tc, opts...)
+	suite.Require().NotNil(ld)
+	return ld
+}
+
+// requireReceived receives from a tracked ticker's channel, then waits for the
+// TrackingClock to record the receipt.
+func (suite *LeakDetectorSuite) requireReceived(t Ticker) {
+	suite.T().Helper()
+	suite.requireSignal(t.C(), WaitALittle)
+
+	e := t.(*trackedTicker).e
+	suite.Eventually(
+		func() bool {
+			suite.tc.lock.Lock()
+			defer suite.tc.lock.Unlock()
+			return e.Unreceived.IsZero()
+		},
+		time.Second,
+		time.Millisecond,
+	)
+}
+
+func (suite *LeakDetectorSuite) TestDefaults() {
+	ld := NewLeakDetector(suite.tc, WithLeakThreshold(-1), WithLeakInterval(0))
+	suite.Equal(DefaultLeakThreshold, ld.threshold)
+	suite.Equal(DefaultLeakThreshold/2, ld.interval)
+	suite.Len(ld.reporters, 1)
+}
+
+func (suite *LeakDetectorSuite) TestTick() {
+	ld := suite.newLeakDetector()
+	tick := func() { suite.tc.Tick(time.Hour) }
+	for i := 0; i < 3; i++ {
+		tick()
+	}
+
+	leaks := ld.Check()
+	suite.Require().Len(leaks, 1)
+	suite.Equal(LeakTick, leaks[0].Reason)
+	suite.Equal(3, leaks[0].Count)
+	suite.Contains(leaks[0].Site.File, "leak_test.go")
+	suite.Equal(uint64(1), leaks[0].Oldest.ID)
+	suite.Equal(leaks, suite.reported)
+
+	// the same site is never reported again
+	tick()
+	suite.Empty(ld.Check())
+}
+
+func (suite *LeakDetectorSuite) TestTicker() {
+	ld := suite.newLeakDetector()
+	leaked := suite.tc.NewTicker(time.Second)
+	received := suite.tc.NewTicker(time.Second)
+	stopped := suite.tc.NewTicker(time.Second)
+	stopped.Stop()
+
+	suite.Empty(ld.Check())
+	for i := 0; i < 3; i++ {
+		suite.Empty(suite.reported)
+		suite.fc.Add(30 * time.Second)
+		suite.requireReceived(received)
+		ld.Check()
+	}
+
+	// the leaked ticker's first tick, after 30 seconds, was never received
+	suite.Require().Len(suite.reported, 1)
+	suite.Equal(LeakTicker, suite.reported[0].Reason)
+	suite.Equal(KindTicker, suite.reported[0].Oldest.Kind)
+	suite.Equal(1, suite.reported[0].Count)
+	suite.Equal(suite.now.Add(90*time.Second), suite.reported[0].Detected)
+
+	leaked.Stop()
+	received.Stop()
+}
+
+func (suite *LeakDetectorSuite) TestTimer() {
+	ld := suite.newLeakDetector()
+	leaked := suite.tc.NewTimer(time.Second)
+	suite.tc.After(time.Second)
+	drained := suite.tc.NewTimer(time.Second)
+	pending := suite.tc.NewTimer(time.Hour)
+	suite.tc.AfterFunc(time.Second, func() {})
+
+	suite.fc.Add(time.Second)
+	suite.requireSignal(drained.C(), WaitALittle)
+	suite.Eventually(
+		func() bool { return len(suite.tc.Tracked()) == 3 },
+		time.Second,
+		time.Millisecond,
+	)
+
+	suite.Empty(ld.Check())
+
+	suite.fc.Add(time.Minute)
+	leaks := ld.Check()
+	suite.Require().Len(leaks, 2)
+	suite.Equal(LeakTimer, leaks[0].Reason)
+	suite.Equal(KindTimer, leaks[0].Oldest.Kind)
+	suite.Equal(LeakTimer, leaks[1].Reason)
+	suite.Equal(KindAfter, leaks[1].Oldest.Kind)
+
+	leaked.Stop()
+	pending.Stop()
+}
+
+func (suite *LeakDetectorSuite) TestSystemClock() {
+	var (
+		lock  sync.Mutex
+		leaks = make(map[LeakReason]Leak)
+		tc    = NewTrackingClock(nil)
+		ld    = NewLeakDetector(
+			tc,
+			WithLeakThreshold(time.Millisecond),
+			WithLeakReporter(LeakReporterFunc(func(l Leak) {
+				lock.Lock()
+				leaks[l.Reason] = l
+				lock.Unlock()
+			})),
+		)
+	)
+
+	leaked := tc.NewTimer(time.Millisecond)
+	drained := tc.NewTimer(time.Millisecond)
+	ticker := tc.NewTicker(time.Millisecond)
+	defer ticker.Stop()
+
+	suite.requireSignal(drained.C(), WaitALittle)
+	suite.Eventually(
+		func() bool { return len(tc.Tracked()) == 2 },
+		time.Second,
+		time.Millisecond,
+	)
+
+	suite.Eventually(
+		func() bool {
+			ld.Check()
+			lock.Lock()
+			defer lock.Unlock()
+			return len(leaks) == 2
+		},
+		time.Second,
+		time.Millisecond,
+	)
+
+	suite.Equal(uint64(1), leaks[LeakTimer].Oldest.ID)
+	suite.Equal(KindTicker, leaks[LeakTicker].Oldest.Kind)
+	leaked.Stop()
+}
+
+func (suite *LeakDetectorSuite) TestRun() {
+	var (
+		onTicker    = make(chan FakeTicker, 1)
+		ctx, cancel = context.WithCancel(context.Background())
+		done        = make(chan error)
+		leaks       = make(chan Leak, 1)
+	)
+
+	suite.fc.NotifyOnTicker(onTicker)
+	ld := NewLeakDetector(
+		suite.tc,
+		WithLeakInterval(time.Second),
+		WithLeakReporter(LeakReporterFunc(func(l Leak) { leaks <- l })),
+	)
+
+	go func() {
+		done <- ld.Run(ctx)
+	}()
+
+	ticker := suite.requireReceive(onTicker, WaitALittle).(FakeTicker)
+	suite.Empty(suite.tc.Tracked()) // the detector's ticker is not tracked
+
+	suite.tc.Tick(time.Hour)
+	suite.fc.Set(ticker.When())
+	suite.Equal(LeakTick, suite.requireReceive(leaks, WaitALittle).(Leak).Reason)
+
+	cancel()
+	suite.ErrorIs(suite.requireReceive(done, WaitALittle).(error), context.Canceled)
+}
+
+func (suite *LeakDetectorSuite) TestSlogLeakReporter() {
+	var (
+		output bytes.Buffer
+		r      = NewSlogLeakReporter(slog.New(slog.NewTextHandler(&output, nil)))
+	)
+
+	suite.NotNil(NewSlogLeakReporter(nil))
+	r.ReportLeak(Leak{
+		Reason: LeakTick,
+		Site:   Site{Function: "main.run", File: "main.go", Line: 12},
+		Count:  2,
+	})
+
+	suite.Contains(output.String(), "level=WARN")
+	suite.Contains(output.String(), "reason=tick")
+	suite.Contains(output.String(), "site=main.go:12")
+	suite.Contains(output.String(), "function=main.run")
+	suite.Contains(output.String(), "count=2")
+}
+
+func (suite *LeakDetectorSuite) TestExpvarLeakReporter() {
+	m := new(expvar.Map)
+	r := NewExpvarLeakReporter(m)
+	r.ReportLeak(Leak{Reason: LeakTick})
+	r.ReportLeak(Leak{Reason: LeakTick})
+	r.ReportLeak(Leak{Reason: LeakTimer})
+
+	suite.Equal("2", m.Get(string(LeakTick)).String())
+	suite.Equal("1", m.Get(string(LeakTimer)).String())
+	suite.Nil(m.Get(string(LeakTicker)))
+}
+
+func TestLeakDetector(t *testing.T) {
+	suite.Run(t, new(LeakDetectorSuite))
+}
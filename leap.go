@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import "time"
+
+// DefaultLeapSmear is the smear window a FakeClock uses for LeapSmear when none is configured.
+const DefaultLeapSmear = 24 * time.Hour
+
+// LeapStrategy describes how a clock handles a leap second.
+type LeapStrategy int
+
+const (
+	// LeapIgnore leaves the wall clock unaffected by the leap second, as with a
+	// device that does not know about it.
+	LeapIgnore LeapStrategy = iota
+
+	// LeapStep repeats the last second of the day: when the leap second begins,
+	// the wall clock steps back from 00:00:00 to 23:59:59.
+	LeapStep
+
+	// LeapSmear slows the wall clock linearly over a window centered on the leap
+	// second, so that it falls behind by exactly one second without ever stepping.
+	LeapSmear
+)
+
+// LeapSecond describes a leap second simulated by a FakeClock.
+type LeapSecond struct {
+	// At is the instant at which the leap second is inserted.  Real leap seconds are
+	// inserted at midnight UTC at the end of June 30 or December 31.
+	At time.Time
+
+	// Strategy is how the clock handles the leap second.
+	Strategy LeapStrategy
+
+	// Smear is the width of the window used with LeapSmear.  If unset,
+	// DefaultLeapSmear is used.
+	Smear time.Duration
+}
+
+// offset returns the amount by which the wall clock trails the continuous
+// timeline at the given time on that timeline.
+func (ls LeapSecond) offset(t time.Time) time.Duration {
+	switch ls.Strategy {
+	case LeapStep:
+		if !t.Before(ls.At) {
+			return -time.Second
+		}
+
+	case LeapSmear:
+		smear := ls.Smear
+		if smear <= 0 {
+			smear = DefaultLeapSmear
+		}
+
+		start := ls.At.Add(-smear / 2)
+		switch elapsed := t.Sub(start); {
+		case elapsed <= 0:
+			return 0
+
+		case elapsed >= smear:
+			return -time.Second
+
+		default:
+			return -time.Duration(float64(time.Second) * float64(elapsed) / float64(smear))
+		}
+	}
+
+	return 0
+}
+
+// WithLeapSecond makes a FakeClock simulate a leap second.  As the clock moves past the
+// leap second, its wall time is adjusted according to the strategy while its monotonic
+// time continues uninterrupted.  This allows testing of code that orders or deduplicates
+// timestamps from devices that handle leap seconds differently.
+//
+// Since timers, tickers, and sleepers follow monotonic time, they are not affected by
+// the leap second.  If the clock does not track monotonic time, they follow the adjusted
+// wall time instead.  The clock's start time is taken to be its adjusted wall time, while
+// times without a monotonic reading passed to Set are taken to be on the continuous
+// timeline, without any adjustment.
+func WithLeapSecond(ls LeapSecond) FakeClockOption {
+	return func(fc *FakeClock) {
+		fc.leap = &ls
+	}
+}
+
+// applyLeap adjusts the wall time of t, which is this clock's new time, for any leap
+// second.  This method must be invoked under this clock's lock.
+func (fc *FakeClock) applyLeap(t time.Time) time.Time {
+	if fc.leap == nil {
+		return t
+	}
+
+	wall := t.Round(0)
+	offset := fc.leap.offset(wall.Add(-fc.leapOffset))
+	if offset == fc.leapOffset {
+		return t
+	}
+
+	wall = wall.Add(offset - fc.leapOffset)
+	fc.leapOffset = offset
+	return withWall(wall, t)
+}
@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LeapSecondSuite struct {
+	ChrononSuite
+
+	// at is the end of 2016, when a leap second was inserted
+	at time.Time
+}
+
+func (suite *LeapSecondSuite) SetupSuite() {
+	suite.ChrononSuite.SetupSuite()
+	suite.at = time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// newLeapClock creates a clock that starts d before the leap second and tracks monotonic time.
+func (suite *LeapSecondSuite) newLeapClock(d time.Duration, ls LeapSecond) *FakeClock {
+	ls.At = suite.at
+	fc := NewFakeClock(withWall(suite.at.Add(-d), time.Now()), WithLeapSecond(ls))
+	suite.Require().True(fc.TracksMonotonic())
+	return fc
+}
+
+// requireWall asserts that the clock's wall time is the given offset from the leap second.
+func (suite *LeapSecondSuite) requireWall(fc *FakeClock, offset time.Duration) {
+	suite.T().Helper()
+	suite.Require().Equal(suite.at.Add(offset), fc.Now().Round(0))
+}
+
+func (suite *LeapSecondSuite) TestStep() {
+	fc := suite.newLeapClock(2*time.Second, LeapSecond{Strategy: LeapStep})
+	start := fc.Now()
+	t := fc.NewTimer(3 * time.Second)
+
+	fc.Add(time.Second)
+	suite.requireWall(fc, -time.Second)
+	last := fc.Now()
+	suite.Equal("23:59:59", last.Format(time.TimeOnly))
+
+	// the 59th second repeats
+	fc.Add(time.Second)
+	suite.requireWall(fc, -time.Second)
+	suite.Equal("23:59:59", fc.Now().Format(time.TimeOnly))
+	suite.True(fc.Now().After(last))
+	suite.Equal(time.Second, fc.Since(last))
+	suite.requireNoSignal(t.C(), Immediate)
+
+	fc.Add(time.Second)
+	suite.requireWall(fc, 0)
+	suite.Equal(3*time.Second, fc.Since(start))
+	suite.requireReceiveEqual(t.C(), fc.Now(), Immediate)
+
+	// the wall clock stays one second behind
+	fc.Add(time.Hour)
+	suite.requireWall(fc, time.Hour)
+}
+
+func (suite *LeapSecondSuite) TestSmear() {
+	fc := suite.newLeapClock(2*time.Hour, LeapSecond{Strategy: LeapSmear, Smear: 2 * time.Hour})
+	start := fc.Now()
+
+	fc.Add(time.Hour)
+	suite.requireWall(fc, -time.Hour)
+
+	fc.Add(30 * time.Minute)
+	suite.requireWall(fc, -30*time.Minute-250*time.Millisecond)
+
+	fc.Add(30 * time.Minute)
+	suite.requireWall(fc, -500*time.Millisecond)
+
+	// the wall clock never steps back
+	previous := fc.Now().Round(0)
+	for i := 0; i < 60; i++ {
+		fc.Add(time.Minute)
+		suite.Require().True(fc.Now().Round(0).After(previous))
+		previous = fc.Now().Round(0)
+	}
+
+	suite.requireWall(fc, time.Hour-time.Second)
+	fc.Add(time.Hour)
+	suite.requireWall(fc, 2*time.Hour-time.Second)
+	suite.Equal(4*time.Hour, fc.Since(start))
+}
+
+func (suite *LeapSecondSuite) TestDefaultSmear() {
+	fc := suite.newLeapClock(12*time.Hour, LeapSecond{Strategy: LeapSmear})
+	fc.Add(12 * time.Hour)
+	suite.requireWall(fc, -500*time.Millisecond)
+	fc.Add(12 * time.Hour)
+	suite.requireWall(fc, 12*time.Hour-time.Second)
+}
+
+func (suite *LeapSecondSuite) TestIgnore() {
+	fc := suite.newLeapClock(time.Second, LeapSecond{Strategy: LeapIgnore})
+	fc.Add(2 * time.Second)
+	suite.requireWall(fc, time.Second)
+}
+
+func (suite *LeapSecondSuite) TestSet() {
+	fc := suite.newLeapClock(time.Minute, LeapSecond{Strategy: LeapStep})
+	t := fc.NewTimer(2 * time.Minute)
+
+	// times passed to Set are on the continuous timeline
+	fc.Set(suite.at.Add(time.Minute))
+	suite.requireWall(fc, time.Minute-time.Second)
+	suite.requireReceiveEqual(t.C(), fc.Now(), Immediate)
+
+	t = fc.NewTimer(time.Minute)
+	_, ok := fc.FireNext()
+	suite.True(ok)
+	suite.requireReceiveEqual(t.C(), fc.Now(), Immediate)
+	suite.requireWall(fc, 2*time.Minute-time.Second)
+
+	fc.Set(suite.at.Add(time.Hour))
+	suite.requireWall(fc, time.Hour-time.Second)
+	fc.Set(suite.at.Add(-time.Hour))
+	suite.requireWall(fc, -time.Hour)
+}
+
+func (suite *LeapSecondSuite) TestSnapshotAndFork() {
+	fc := suite.newLeapClock(time.Second, LeapSecond{Strategy: LeapStep})
+	s := fc.Snapshot()
+
+	fc.Add(time.Second)
+	fork := fc.Fork()
+	fc.Restore(s)
+	suite.requireWall(fc, -time.Second)
+	fc.Add(time.Second)
+	suite.requireWall(fc, -time.Second)
+
+	fork.Add(time.Second)
+	suite.requireWall(fork, 0)
+}
+
+func (suite *LeapSecondSuite) TestStartAfterLeap() {
+	fc := suite.newLeapClock(-time.Hour, LeapSecond{Strategy: LeapStep})
+	suite.requireWall(fc, time.Hour)
+	fc.Add(time.Second)
+	suite.requireWall(fc, time.Hour+time.Second)
+}
+
+func TestLeapSecond(t *testing.T) {
+	suite.Run(t, new(LeapSecondSuite))
+}
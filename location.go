@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import "time"
+
+// locationClock is a Clock that normalizes the times produced by another Clock.
+type locationClock struct {
+	base           Clock
+	loc            *time.Location
+	stripMonotonic bool
+}
+
+// LocationOption represents a configurable option for the Clocks returned by
+// InLocation and UTC.
+type LocationOption func(*locationClock)
+
+// WithoutMonotonic strips the monotonic clock reading from every time a Clock produces.
+// Comparisons and durations involving those times then use wall time, which is how times
+// behave after being serialized or after passing through t.Round(0).  This can expose
+// code that only works because of monotonic readings.
+func WithoutMonotonic() LocationOption {
+	return func(lc *locationClock) {
+		lc.stripMonotonic = true
+	}
+}
+
+// InLocation returns a Clock whose times, including the times sent by its timers and
+// tickers, are always in the given location.  This prevents code from accidentally
+// mixing times in different locations.  If loc is nil, locations are left unchanged,
+// which is useful along with WithoutMonotonic.  If base is nil, SystemClock() is used.
+func InLocation(base Clock, loc *time.Location, opts ...LocationOption) Clock {
+	if base == nil {
+		base = SystemClock()
+	}
+
+	lc := &locationClock{
+		base: base,
+		loc:  loc,
+	}
+
+	for _, o := range opts {
+		o(lc)
+	}
+
+	return lc
+}
+
+// UTC returns a Clock whose times are always in UTC.  This is shorthand for
+// InLocation(base, time.UTC, opts...).
+func UTC(base Clock, opts ...LocationOption) Clock {
+	return InLocation(base, time.UTC, opts...)
+}
+
+// normalize applies this clock's location and monotonic policy to t.
+func (lc *locationClock) normalize(t time.Time) time.Time {
+	if lc.stripMonotonic {
+		t = t.Round(0)
+	}
+
+	if lc.loc != nil {
+		// In also strips the monotonic reading, which must be preserved
+		t = withWall(t.In(lc.loc), t)
+	}
+
+	return t
+}
+
+func (lc *locationClock) Now() time.Time {
+	return lc.normalize(lc.base.Now())
+}
+
+func (lc *locationClock) Since(t time.Time) time.Duration {
+	return lc.Now().Sub(t)
+}
+
+func (lc *locationClock) Until(t time.Time) time.Duration {
+	return t.Sub(lc.Now())
+}
+
+func (lc *locationClock) Sleep(d time.Duration) {
+	lc.base.Sleep(d)
+}
+
+func (lc *locationClock) After(d time.Duration) <-chan time.Time {
+	return lc.NewTimer(d).C()
+}
+
+func (lc *locationClock) AfterFunc(d time.Duration, f func()) Timer {
+	return lc.base.AfterFunc(d, f)
+}
+
+func (lc *locationClock) Tick(d time.Duration) <-chan time.Time {
+	if d <= 0 {
+		// consistent with time.Tick
+		return nil
+	}
+
+	return lc.NewTicker(d).C()
+}
+
+func (lc *locationClock) NewTicker(d time.Duration) Ticker {
+	return newObservedTicker(lc, make(chan time.Time, 1), d, d, unobserved)
+}
+
+func (lc *locationClock) NewTimer(d time.Duration) Timer {
+	return newObservedTimer(lc, d, make(chan time.Time, 1), nil, unobserved)
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata" // the tests must not depend on the host's zoneinfo
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LocationSuite struct {
+	ChrononSuite
+
+	tokyo *time.Location
+}
+
+func (suite *LocationSuite) SetupSuite() {
+	suite.ChrononSuite.SetupSuite()
+
+	var err error
+	suite.tokyo, err = time.LoadLocation("Asia/Tokyo")
+	suite.Require().NoError(err)
+}
+
+// requireIn asserts that t is in the given location.
+func (suite *LocationSuite) requireIn(loc *time.Location, t interface{}) {
+	suite.T().Helper()
+	suite.Require().IsType(time.Time{}, t)
+	suite.Require().Same(loc, t.(time.Time).Location())
+}
+
+func (suite *LocationSuite) TestInLocation() {
+	fc := suite.newFakeClock()
+	c := InLocation(fc, suite.tokyo)
+
+	suite.requireIn(suite.tokyo, c.Now())
+	suite.True(hasMonotonicReading(c.Now()))
+	suite.Equal(fc.Now().Round(0), c.Now().Round(0).In(fc.Now().Location()))
+	suite.Equal(time.Second, c.Until(fc.Now().Add(time.Second)))
+	suite.Equal(time.Second, c.Since(fc.Now().Add(-time.Second)))
+
+	t := c.NewTimer(time.Second)
+	after := c.After(time.Second)
+	ticker := c.NewTicker(time.Second)
+	tick := c.Tick(time.Second)
+	called := make(chan struct{})
+	c.AfterFunc(time.Second, func() { close(called) })
+	suite.Nil(c.Tick(0))
+
+	fc.Add(time.Second)
+	suite.requireIn(suite.tokyo, suite.requireReceive(t.C(), Immediate))
+	suite.requireIn(suite.tokyo, suite.requireReceive(after, Immediate))
+	suite.requireIn(suite.tokyo, suite.requireReceive(ticker.C(), Immediate))
+	suite.requireIn(suite.tokyo, suite.requireReceive(tick, Immediate))
+	suite.requireSignal(called, Immediate)
+	ticker.Stop()
+
+	done := make(chan struct{})
+	onSleep := make(chan Sleeper, 1)
+	fc.NotifyOnSleep(onSleep)
+	go func() {
+		defer close(done)
+		c.Sleep(time.Second)
+	}()
+
+	suite.requireReceive(onSleep, WaitALittle)
+	fc.Add(time.Second)
+	suite.requireSignal(done, WaitALittle)
+}
+
+func (suite *LocationSuite) TestUTC() {
+	c := UTC(NewFakeClock(suite.now.In(suite.tokyo)))
+	suite.requireIn(time.UTC, c.Now())
+
+	c = UTC(nil)
+	suite.requireIn(time.UTC, c.Now())
+	suite.WithinDuration(time.Now(), c.Now(), time.Second)
+}
+
+func (suite *LocationSuite) TestWithoutMonotonic() {
+	fc := suite.newFakeClock()
+	suite.Require().True(hasMonotonicReading(fc.Now()))
+
+	c := InLocation(fc, nil, WithoutMonotonic())
+	suite.False(hasMonotonicReading(c.Now()))
+	suite.Same(fc.Now().Location(), c.Now().Location())
+
+	// durations now follow wall time, so a wall step is visible
+	start := c.Now()
+	fc.StepWall(time.Hour)
+	suite.Equal(time.Hour, c.Since(start))
+
+	t := c.NewTimer(time.Second)
+	fc.Add(time.Second)
+	suite.False(hasMonotonicReading(suite.requireReceive(t.C(), Immediate).(time.Time)))
+
+	c = UTC(fc, WithoutMonotonic())
+	suite.requireIn(time.UTC, c.Now())
+	suite.False(hasMonotonicReading(c.Now()))
+}
+
+func (suite *LocationSuite) TestNewFakeClockInZone() {
+	fc, err := NewFakeClockInZone("Asia/Tokyo", "2024-03-10 01:59:00")
+	suite.Require().NoError(err)
+	suite.Equal("Asia/Tokyo", fc.Now().Location().String())
+	suite.Equal(time.Date(2024, time.March, 10, 1, 59, 0, 0, fc.Now().Location()), fc.Now().Round(0))
+	suite.True(hasMonotonicReading(fc.Now()))
+
+	fc.Add(time.Hour)
+	suite.Equal(2, fc.Now().Hour())
+	suite.Equal("Asia/Tokyo", fc.Now().Location().String())
+
+	_, err = NewFakeClockInZone("Nowhere/Nothing", "2024-03-10 01:59:00")
+	suite.Error(err)
+
+	_, err = NewFakeClockInZone("Asia/Tokyo", "invalid")
+	suite.Error(err)
+}
+
+func (suite *LocationSuite) TestWithLocation() {
+	fc := NewFakeClock(suite.now, WithLocation(suite.tokyo), WithSkew(time.Minute))
+	suite.requireIn(suite.tokyo, fc.Now())
+	suite.True(hasMonotonicReading(fc.Now()))
+	suite.Equal(time.Minute, fc.Now().Round(0).Sub(suite.now.Round(0)))
+
+	t := fc.NewTimer(time.Second)
+	fc.Add(time.Second)
+	suite.requireIn(suite.tokyo, suite.requireReceive(t.C(), Immediate))
+
+	fc.Set(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC))
+	suite.requireIn(suite.tokyo, fc.Now())
+	suite.Equal(9, fc.Now().Hour())
+}
+
+func TestLocation(t *testing.T) {
+	suite.Run(t, new(LocationSuite))
+}
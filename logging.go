@@ -0,0 +1,259 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"context"
+	"log/slog"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// LoggingClockOption represents a configurable option for a LoggingClock.
+type LoggingClockOption func(*LoggingClock)
+
+// WithLogLevel sets the level at which a LoggingClock logs.  The default is slog.LevelDebug.
+func WithLogLevel(level slog.Leveler) LoggingClockOption {
+	return func(lc *LoggingClock) {
+		lc.level = level
+	}
+}
+
+// WithLogSampling causes a LoggingClock to log only the first of every n events
+// with the same message.  For example, with n set to 100, the 1st, 101st, 201st, etc.
+// ticks are logged.  Values less than 2 disable sampling, which is the default.
+func WithLogSampling(n int) LoggingClockOption {
+	return func(lc *LoggingClock) {
+		lc.sampling = 0
+		if n > 1 {
+			lc.sampling = uint64(n)
+		}
+	}
+}
+
+// WithLogCaller controls whether a LoggingClock attributes each record to the code that
+// invoked the clock.  Records for fired timers and ticks are attributed to the code that
+// created the timer or ticker.  Handlers report this attribution when configured with
+// slog.HandlerOptions.AddSource.  Attribution is disabled by default, since it costs a
+// stack walk per call.
+func WithLogCaller(caller bool) LoggingClockOption {
+	return func(lc *LoggingClock) {
+		lc.caller = caller
+	}
+}
+
+// LoggingClock is a Clock decorator that logs the creation, reset, stop, and firing of
+// timers and tickers, as well as each sleep, to a *slog.Logger.  Each record is stamped
+// with the base clock's time and carries an "id" attribute that correlates the records
+// for the same timer or ticker.
+//
+// Timers and tickers created through a LoggingClock are driven by AfterFunc calls on
+// the base clock.  When the base clock is a *FakeClock, they fire as the fake clock is
+// advanced, but they do not implement FakeTimer or FakeTicker.
+type LoggingClock struct {
+	base     Clock
+	logger   *slog.Logger
+	level    slog.Leveler
+	sampling uint64
+	caller   bool
+
+	lock   sync.Mutex
+	lastID uint64
+	counts map[string]uint64
+}
+
+var _ Clock = (*LoggingClock)(nil)
+
+// NewLoggingClock creates a LoggingClock that decorates the given base Clock and logs
+// to the given logger.  If base is nil, SystemClock() is used.  If logger is nil,
+// slog.Default() is used.
+func NewLoggingClock(base Clock, logger *slog.Logger, opts ...LoggingClockOption) *LoggingClock {
+	if base == nil {
+		base = SystemClock()
+	}
+
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	lc := &LoggingClock{
+		base:   base,
+		logger: logger,
+		level:  slog.LevelDebug,
+		counts: make(map[string]uint64),
+	}
+
+	for _, o := range opts {
+		o(lc)
+	}
+
+	return lc
+}
+
+// nextID returns the identifier for a new timer or ticker.
+func (lc *LoggingClock) nextID() (id uint64) {
+	lc.lock.Lock()
+	lc.lastID++
+	id = lc.lastID
+	lc.lock.Unlock()
+	return
+}
+
+// callerPC returns the program counter of the code that invoked a LoggingClock
+// method, or 0 if caller attribution is disabled.
+func (lc *LoggingClock) callerPC() uintptr {
+	if !lc.caller {
+		return 0
+	}
+
+	// skip runtime.Callers, callerPC, and the LoggingClock method
+	var pcs [1]uintptr
+	runtime.Callers(3, pcs[:])
+	return pcs[0]
+}
+
+// sample tests if the next event with the given message should be logged.
+func (lc *LoggingClock) sample(msg string) bool {
+	if lc.sampling == 0 {
+		return true
+	}
+
+	lc.lock.Lock()
+	n := lc.counts[msg]
+	lc.counts[msg] = n + 1
+	lc.lock.Unlock()
+
+	return n%lc.sampling == 0
+}
+
+// log emits a record attributed to the given program counter.
+func (lc *LoggingClock) log(pc uintptr, msg string, attrs ...slog.Attr) {
+	ctx := context.Background()
+	level := lc.level.Level()
+	if !lc.logger.Enabled(ctx, level) || !lc.sample(msg) {
+		return
+	}
+
+	r := slog.NewRecord(lc.base.Now(), level, msg, pc)
+	r.AddAttrs(attrs...)
+	lc.logger.Handler().Handle(ctx, r) // nolint:errcheck
+}
+
+func (lc *LoggingClock) Now() time.Time {
+	return lc.base.Now()
+}
+
+func (lc *LoggingClock) Since(t time.Time) time.Duration {
+	return lc.base.Since(t)
+}
+
+func (lc *LoggingClock) Until(t time.Time) time.Duration {
+	return lc.base.Until(t)
+}
+
+func (lc *LoggingClock) Sleep(d time.Duration) {
+	pc := lc.callerPC()
+	lc.log(pc, "sleep started", slog.Duration("duration", d))
+
+	start := lc.base.Now()
+	lc.base.Sleep(d)
+	lc.log(pc, "sleep finished", slog.Duration("duration", d), slog.Duration("elapsed", lc.base.Since(start)))
+}
+
+func (lc *LoggingClock) After(d time.Duration) <-chan time.Time {
+	return lc.newTimer(lc.callerPC(), KindAfter, d, make(chan time.Time, 1), nil).C()
+}
+
+func (lc *LoggingClock) AfterFunc(d time.Duration, f func()) Timer {
+	return lc.newTimer(lc.callerPC(), KindAfterFunc, d, nil, f)
+}
+
+func (lc *LoggingClock) Tick(d time.Duration) <-chan time.Time {
+	if d <= 0 {
+		// consistent with time.Tick
+		return nil
+	}
+
+	return lc.newTicker(lc.callerPC(), KindTick, d).C()
+}
+
+func (lc *LoggingClock) NewTicker(d time.Duration) Ticker {
+	return lc.newTicker(lc.callerPC(), KindTicker, d)
+}
+
+func (lc *LoggingClock) NewTimer(d time.Duration) Timer {
+	return lc.newTimer(lc.callerPC(), KindTimer, d, make(chan time.Time, 1), nil)
+}
+
+// newTimer creates a timer that either sends on c or invokes f when it fires.
+func (lc *LoggingClock) newTimer(pc uintptr, kind Kind, d time.Duration, c chan time.Time, f func()) *loggingTimer {
+	lt := &loggingTimer{
+		lc: lc,
+		id: slog.Uint64("id", lc.nextID()),
+	}
+
+	// the timer may fire immediately, so log its creation first
+	lc.log(pc, "timer created", lt.id, slog.String("kind", string(kind)), slog.Duration("duration", d))
+	lt.observedTimer = newObservedTimer(lc.base, d, c, f, func(when, now time.Time) {
+		lc.log(pc, "timer fired", lt.id, slog.Time("when", when), slog.Duration("lateness", now.Sub(when)))
+	})
+
+	return lt
+}
+
+// newTicker creates a ticker with the given interval.
+func (lc *LoggingClock) newTicker(pc uintptr, kind Kind, d time.Duration) *loggingTicker {
+	lt := &loggingTicker{
+		lc: lc,
+		id: slog.Uint64("id", lc.nextID()),
+	}
+
+	lt.observedTicker = newObservedTicker(lc.base, make(chan time.Time, 1), d, d, func(when, now time.Time) {
+		lc.log(pc, "tick", lt.id, slog.Time("when", when), slog.Duration("lateness", now.Sub(when)))
+	})
+
+	lc.log(pc, "ticker created", lt.id, slog.String("kind", string(kind)), slog.Duration("interval", d))
+	return lt
+}
+
+// loggingTimer is a Timer created through a LoggingClock.
+type loggingTimer struct {
+	*observedTimer
+	lc *LoggingClock
+	id slog.Attr
+}
+
+func (lt *loggingTimer) Reset(d time.Duration) bool {
+	pc := lt.lc.callerPC()
+	active := lt.observedTimer.Reset(d)
+	lt.lc.log(pc, "timer reset", lt.id, slog.Duration("duration", d), slog.Bool("active", active))
+	return active
+}
+
+func (lt *loggingTimer) Stop() bool {
+	pc := lt.lc.callerPC()
+	stopped := lt.observedTimer.Stop()
+	lt.lc.log(pc, "timer stopped", lt.id, slog.Bool("active", stopped))
+	return stopped
+}
+
+// loggingTicker is a Ticker created through a LoggingClock.
+type loggingTicker struct {
+	*observedTicker
+	lc *LoggingClock
+	id slog.Attr
+}
+
+func (lt *loggingTicker) Reset(d time.Duration) {
+	pc := lt.lc.callerPC()
+	lt.reset(d)
+	lt.lc.log(pc, "ticker reset", lt.id, slog.Duration("interval", d))
+}
+
+func (lt *loggingTicker) Stop() {
+	pc := lt.lc.callerPC()
+	stopped := lt.stop()
+	lt.lc.log(pc, "ticker stopped", lt.id, slog.Bool("active", stopped))
+}
@@ -0,0 +1,224 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LoggingClockSuite struct {
+	ChrononSuite
+
+	fc     *FakeClock
+	output bytes.Buffer
+}
+
+func (suite *LoggingClockSuite) SetupTest() {
+	suite.fc = suite.newFakeClock()
+	suite.output.Reset()
+}
+
+func (suite *LoggingClockSuite) newLoggingClock(opts ...LoggingClockOption) *LoggingClock {
+	logger := slog.New(slog.NewJSONHandler(&suite.output, &slog.HandlerOptions{
+		AddSource: true,
+		Level:     slog.LevelDebug,
+	}))
+
+	lc := NewLoggingClock(suite.fc, logger, opts...)
+	suite.Require().NotNil(lc)
+	return lc
+}
+
+// records parses and clears the records logged so far.
+func (suite *LoggingClockSuite) records() (records []map[string]any) {
+	suite.T().Helper()
+	for _, line := range bytes.Split(bytes.TrimSpace(suite.output.Bytes()), []byte("\n")) {
+		if len(line) > 0 {
+			var r map[string]any
+			suite.Require().NoError(json.Unmarshal(line, &r))
+			records = append(records, r)
+		}
+	}
+
+	suite.output.Reset()
+	return
+}
+
+// messages returns the messages of the records logged so far, clearing them.
+func (suite *LoggingClockSuite) messages() (msgs []string) {
+	suite.T().Helper()
+	for _, r := range suite.records() {
+		msgs = append(msgs, r[slog.MessageKey].(string))
+	}
+
+	return
+}
+
+func (suite *LoggingClockSuite) TestDefaults() {
+	lc := NewLoggingClock(nil, nil)
+	suite.True(IsSystemClock(lc.base))
+	suite.Equal(slog.Default(), lc.logger)
+	suite.Equal(slog.LevelDebug, lc.level.Level())
+}
+
+func (suite *LoggingClockSuite) TestNow() {
+	lc := suite.newLoggingClock()
+	suite.Equal(suite.fc.Now(), lc.Now())
+	suite.Equal(time.Second, lc.Until(suite.now.Add(time.Second)))
+	suite.Equal(time.Second, lc.Since(suite.now.Add(-time.Second)))
+	suite.Empty(suite.records())
+}
+
+func (suite *LoggingClockSuite) TestNewTimer() {
+	lc := suite.newLoggingClock()
+	t := lc.NewTimer(time.Second)
+	records := suite.records()
+	suite.Require().Len(records, 1)
+	suite.Equal("timer created", records[0][slog.MessageKey])
+	suite.Equal("DEBUG", records[0][slog.LevelKey])
+	suite.Equal(string(KindTimer), records[0]["kind"])
+	suite.Equal(float64(time.Second), records[0]["duration"])
+	suite.Equal(1.0, records[0]["id"])
+	suite.Nil(records[0][slog.SourceKey])
+
+	suite.fc.Add(time.Second + time.Millisecond)
+	suite.requireSignal(t.C(), Immediate)
+	records = suite.records()
+	suite.Require().Len(records, 1)
+	suite.Equal("timer fired", records[0][slog.MessageKey])
+	suite.Equal(float64(time.Millisecond), records[0]["lateness"])
+
+	// records are stamped with the clock's time
+	stamp, err := time.Parse(time.RFC3339Nano, records[0][slog.TimeKey].(string))
+	suite.Require().NoError(err)
+	suite.True(stamp.Equal(suite.fc.Now()))
+
+	suite.False(t.Reset(time.Second))
+	suite.True(t.Stop())
+	suite.Equal([]string{"timer reset", "timer stopped"}, suite.messages())
+}
+
+func (suite *LoggingClockSuite) TestAfter() {
+	lc := suite.newLoggingClock()
+	ch := lc.After(time.Second)
+	suite.fc.Add(time.Second)
+	suite.requireSignal(ch, Immediate)
+
+	records := suite.records()
+	suite.Require().Len(records, 2)
+	suite.Equal(string(KindAfter), records[0]["kind"])
+	suite.Equal("timer fired", records[1][slog.MessageKey])
+}
+
+func (suite *LoggingClockSuite) TestAfterFunc() {
+	lc := suite.newLoggingClock()
+	called := false
+	lc.AfterFunc(0, func() { called = true })
+	suite.True(called)
+	suite.Equal([]string{"timer created", "timer fired"}, suite.messages())
+}
+
+func (suite *LoggingClockSuite) TestNewTicker() {
+	lc := suite.newLoggingClock()
+	t := lc.NewTicker(time.Second)
+	suite.fc.Add(time.Second)
+	suite.requireSignal(t.C(), Immediate)
+	t.Reset(time.Minute)
+	t.Stop()
+
+	records := suite.records()
+	suite.Require().Len(records, 4)
+	suite.Equal("ticker created", records[0][slog.MessageKey])
+	suite.Equal(string(KindTicker), records[0]["kind"])
+	suite.Equal("tick", records[1][slog.MessageKey])
+	suite.Equal("ticker reset", records[2][slog.MessageKey])
+	suite.Equal("ticker stopped", records[3][slog.MessageKey])
+	suite.Equal(true, records[3]["active"])
+}
+
+func (suite *LoggingClockSuite) TestTick() {
+	lc := suite.newLoggingClock()
+	suite.Nil(lc.Tick(0))
+	suite.NotNil(lc.Tick(time.Second))
+
+	records := suite.records()
+	suite.Require().Len(records, 1)
+	suite.Equal(string(KindTick), records[0]["kind"])
+}
+
+func (suite *LoggingClockSuite) TestSleep() {
+	lc := suite.newLoggingClock()
+	onSleep := make(chan Sleeper, 1)
+	suite.fc.NotifyOnSleep(onSleep)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		lc.Sleep(time.Second)
+	}()
+
+	suite.requireReceive(onSleep, WaitALittle)
+	suite.fc.Add(time.Second)
+	suite.requireSignal(done, WaitALittle)
+	suite.Equal([]string{"sleep started", "sleep finished"}, suite.messages())
+}
+
+func (suite *LoggingClockSuite) TestLevel() {
+	lc := suite.newLoggingClock(WithLogLevel(slog.LevelInfo))
+	lc.NewTimer(time.Second)
+	records := suite.records()
+	suite.Require().Len(records, 1)
+	suite.Equal("INFO", records[0][slog.LevelKey])
+
+	// disabled levels are not logged
+	lc = NewLoggingClock(suite.fc, slog.New(slog.NewJSONHandler(&suite.output, nil)))
+	lc.NewTimer(time.Second)
+	suite.Empty(suite.records())
+}
+
+func (suite *LoggingClockSuite) TestSampling() {
+	lc := suite.newLoggingClock(WithLogSampling(3))
+	t := lc.NewTicker(time.Second)
+	for i := 0; i < 7; i++ {
+		suite.fc.Add(time.Second)
+		suite.requireSignal(t.C(), Immediate)
+	}
+
+	// ticks 1, 4, and 7 are logged
+	suite.Equal([]string{"ticker created", "tick", "tick", "tick"}, suite.messages())
+
+	lc = suite.newLoggingClock(WithLogSampling(3), WithLogSampling(1))
+	for i := 0; i < 3; i++ {
+		lc.NewTimer(time.Second)
+	}
+
+	suite.Len(suite.records(), 3)
+}
+
+func (suite *LoggingClockSuite) TestCaller() {
+	lc := suite.newLoggingClock(WithLogCaller(true))
+	t := lc.NewTimer(time.Second)
+	suite.fc.Add(time.Second)
+	suite.requireSignal(t.C(), Immediate)
+	t.Stop()
+
+	records := suite.records()
+	suite.Require().Len(records, 3)
+	for _, r := range records {
+		source, ok := r[slog.SourceKey].(map[string]any)
+		suite.Require().True(ok)
+		suite.Contains(source["file"], "logging_test.go")
+		suite.Contains(source["function"], "TestCaller")
+	}
+}
+
+func TestLoggingClock(t *testing.T) {
+	suite.Run(t, new(LoggingClockSuite))
+}
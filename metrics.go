@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"expvar"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// The names of the metrics a MetricsClock reports.  Gauges, whose names end in
+// "_active", receive both positive and negative deltas.  Durations are observed
+// as distributions.
+const (
+	MetricTimersCreated  = "timers_created"
+	MetricTimersActive   = "timers_active"
+	MetricTimersStopped  = "timers_stopped"
+	MetricTimersFired    = "timers_fired"
+	MetricTimerLateness  = "timer_lateness"
+	MetricTickersCreated = "tickers_created"
+	MetricTickersActive  = "tickers_active"
+	MetricTickersStopped = "tickers_stopped"
+	MetricTicksFired     = "ticks_fired"
+	MetricTickLateness   = "tick_lateness"
+	MetricSleepsStarted  = "sleeps_started"
+	MetricSleepsActive   = "sleeps_active"
+	MetricSleepDuration  = "sleep_duration"
+)
+
+// MetricsSink receives the measurements made by a MetricsClock.  Implementations
+// adapt these measurements to a particular metrics library and must be safe for
+// concurrent use.
+type MetricsSink interface {
+	// Add adds delta to the named counter or gauge.
+	Add(name string, delta int64)
+
+	// Observe records a duration in the named distribution.
+	Observe(name string, d time.Duration)
+}
+
+// expvarDistribution summarizes observed durations as an expvar.Var.
+type expvarDistribution struct {
+	lock  sync.Mutex
+	count int64
+	total time.Duration
+	max   time.Duration
+}
+
+func (ed *expvarDistribution) observe(d time.Duration) {
+	ed.lock.Lock()
+	ed.count++
+	ed.total += d
+	if ed.count == 1 || d > ed.max {
+		ed.max = d
+	}
+
+	ed.lock.Unlock()
+}
+
+// String returns the JSON representation of this distribution.  Durations are in nanoseconds.
+func (ed *expvarDistribution) String() string {
+	ed.lock.Lock()
+	defer ed.lock.Unlock()
+	return fmt.Sprintf(`{"count": %d, "total": %d, "max": %d}`, ed.count, ed.total, ed.max)
+}
+
+// expvarMetricsSink is a MetricsSink backed by an expvar.Map.
+type expvarMetricsSink struct {
+	m    *expvar.Map
+	lock sync.Mutex
+}
+
+func (s *expvarMetricsSink) Add(name string, delta int64) {
+	s.m.Add(name, delta)
+}
+
+func (s *expvarMetricsSink) Observe(name string, d time.Duration) {
+	s.lock.Lock()
+	ed, ok := s.m.Get(name).(*expvarDistribution)
+	if !ok {
+		ed = new(expvarDistribution)
+		s.m.Set(name, ed)
+	}
+
+	s.lock.Unlock()
+	ed.observe(d)
+}
+
+// NewExpvarMetricsSink returns a MetricsSink that publishes metrics in the given map.
+// Counters and gauges are expvar.Int values.  Each distribution is a JSON object with
+// the count, total, and max of the observed durations, in nanoseconds.  Typically, m
+// is created with expvar.NewMap so that the metrics are published.
+func NewExpvarMetricsSink(m *expvar.Map) MetricsSink {
+	return &expvarMetricsSink{m: m}
+}
+
+// MetricsClock is a Clock decorator that reports how timers, tickers, and sleeps
+// are used through a MetricsSink.  Besides counts, it measures how late each timer
+// and tick fires, which is the time at which it actually fired less the time at which
+// it was scheduled to fire.  Lateness grows as a process comes under load.
+//
+// Timers and tickers created through a MetricsClock are driven by AfterFunc calls on
+// the base clock.  When the base clock is a *FakeClock, they fire as the fake clock is
+// advanced, but they do not implement FakeTimer or FakeTicker.
+type MetricsClock struct {
+	base Clock
+	sink MetricsSink
+}
+
+var _ Clock = (*MetricsClock)(nil)
+
+// NewMetricsClock creates a MetricsClock that decorates the given base Clock and
+// reports to the given sink, which must not be nil.  If base is nil, SystemClock() is used.
+func NewMetricsClock(base Clock, sink MetricsSink) *MetricsClock {
+	if base == nil {
+		base = SystemClock()
+	}
+
+	return &MetricsClock{
+		base: base,
+		sink: sink,
+	}
+}
+
+func (mc *MetricsClock) Now() time.Time {
+	return mc.base.Now()
+}
+
+func (mc *MetricsClock) Since(t time.Time) time.Duration {
+	return mc.base.Since(t)
+}
+
+func (mc *MetricsClock) Until(t time.Time) time.Duration {
+	return mc.base.Until(t)
+}
+
+func (mc *MetricsClock) Sleep(d time.Duration) {
+	mc.sink.Add(MetricSleepsStarted, 1)
+	mc.sink.Add(MetricSleepsActive, 1)
+
+	start := mc.base.Now()
+	mc.base.Sleep(d)
+	mc.sink.Observe(MetricSleepDuration, mc.base.Since(start))
+	mc.sink.Add(MetricSleepsActive, -1)
+}
+
+func (mc *MetricsClock) After(d time.Duration) <-chan time.Time {
+	return mc.NewTimer(d).C()
+}
+
+func (mc *MetricsClock) AfterFunc(d time.Duration, f func()) Timer {
+	return mc.newTimer(d, nil, f)
+}
+
+func (mc *MetricsClock) Tick(d time.Duration) <-chan time.Time {
+	if d <= 0 {
+		// consistent with time.Tick
+		return nil
+	}
+
+	return mc.NewTicker(d).C()
+}
+
+func (mc *MetricsClock) NewTicker(d time.Duration) Ticker {
+	mt := &metricsTicker{
+		observedTicker: newObservedTicker(mc.base, make(chan time.Time, 1), d, d, func(when, now time.Time) {
+			mc.sink.Add(MetricTicksFired, 1)
+			mc.sink.Observe(MetricTickLateness, now.Sub(when))
+		}),
+		mc: mc,
+	}
+
+	mc.sink.Add(MetricTickersCreated, 1)
+	mc.sink.Add(MetricTickersActive, 1)
+	return mt
+}
+
+func (mc *MetricsClock) NewTimer(d time.Duration) Timer {
+	return mc.newTimer(d, make(chan time.Time, 1), nil)
+}
+
+// newTimer creates a timer that either sends on c or invokes f when it fires.
+func (mc *MetricsClock) newTimer(d time.Duration, c chan time.Time, f func()) *metricsTimer {
+	// the timer may fire immediately, so count it first
+	mc.sink.Add(MetricTimersCreated, 1)
+	mc.sink.Add(MetricTimersActive, 1)
+
+	return &metricsTimer{
+		observedTimer: newObservedTimer(mc.base, d, c, f, func(when, now time.Time) {
+			mc.sink.Add(MetricTimersFired, 1)
+			mc.sink.Add(MetricTimersActive, -1)
+			mc.sink.Observe(MetricTimerLateness, now.Sub(when))
+		}),
+		mc: mc,
+	}
+}
+
+// metricsTimer is a Timer created through a MetricsClock.
+type metricsTimer struct {
+	*observedTimer
+	mc *MetricsClock
+}
+
+func (mt *metricsTimer) Reset(d time.Duration) bool {
+	active := mt.observedTimer.Reset(d)
+	if !active {
+		mt.mc.sink.Add(MetricTimersActive, 1)
+	}
+
+	return active
+}
+
+func (mt *metricsTimer) Stop() bool {
+	stopped := mt.observedTimer.Stop()
+	if stopped {
+		mt.mc.sink.Add(MetricTimersStopped, 1)
+		mt.mc.sink.Add(MetricTimersActive, -1)
+	}
+
+	return stopped
+}
+
+// metricsTicker is a Ticker created through a MetricsClock.
+type metricsTicker struct {
+	*observedTicker
+	mc *MetricsClock
+}
+
+func (mt *metricsTicker) Reset(d time.Duration) {
+	if mt.reset(d) {
+		mt.mc.sink.Add(MetricTickersActive, 1)
+	}
+}
+
+func (mt *metricsTicker) Stop() {
+	if mt.stop() {
+		mt.mc.sink.Add(MetricTickersStopped, 1)
+		mt.mc.sink.Add(MetricTickersActive, -1)
+	}
+}
@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"encoding/json"
+	"expvar"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// testMetricsSink records everything reported to it.
+type testMetricsSink struct {
+	lock         sync.Mutex
+	values       map[string]int64
+	observations map[string][]time.Duration
+}
+
+func (s *testMetricsSink) Add(name string, delta int64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.values == nil {
+		s.values = make(map[string]int64)
+	}
+
+	s.values[name] += delta
+}
+
+func (s *testMetricsSink) Observe(name string, d time.Duration) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.observations == nil {
+		s.observations = make(map[string][]time.Duration)
+	}
+
+	s.observations[name] = append(s.observations[name], d)
+}
+
+func (s *testMetricsSink) value(name string) int64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.values[name]
+}
+
+func (s *testMetricsSink) observed(name string) []time.Duration {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]time.Duration(nil), s.observations[name]...)
+}
+
+type MetricsClockSuite struct {
+	ChrononSuite
+
+	fc   *FakeClock
+	sink *testMetricsSink
+	mc   *MetricsClock
+}
+
+func (suite *MetricsClockSuite) SetupTest() {
+	suite.fc = suite.newFakeClock()
+	suite.sink = new(testMetricsSink)
+	suite.mc = NewMetricsClock(suite.fc, suite.sink)
+}
+
+// requireValues asserts the current values of the given metrics.
+func (suite *MetricsClockSuite) requireValues(expected map[string]int64) {
+	suite.T().Helper()
+	for name, value := range expected {
+		suite.Equalf(value, suite.sink.value(name), "metric: %s", name)
+	}
+}
+
+func (suite *MetricsClockSuite) TestDefault() {
+	mc := NewMetricsClock(nil, suite.sink)
+	suite.True(IsSystemClock(mc.base))
+}
+
+func (suite *MetricsClockSuite) TestNow() {
+	suite.Equal(suite.fc.Now(), suite.mc.Now())
+	suite.Equal(time.Second, suite.mc.Until(suite.now.Add(time.Second)))
+	suite.Equal(time.Second, suite.mc.Since(suite.now.Add(-time.Second)))
+}
+
+func (suite *MetricsClockSuite) TestNewTimer() {
+	t := suite.mc.NewTimer(time.Second)
+	suite.requireValues(map[string]int64{MetricTimersCreated: 1, MetricTimersActive: 1})
+
+	suite.fc.Add(time.Second + time.Millisecond)
+	suite.requireReceiveEqual(t.C(), suite.now.Add(time.Second+time.Millisecond), Immediate)
+	suite.requireValues(map[string]int64{MetricTimersFired: 1, MetricTimersActive: 0})
+	suite.Equal([]time.Duration{time.Millisecond}, suite.sink.observed(MetricTimerLateness))
+
+	suite.False(t.Reset(time.Second))
+	suite.requireValues(map[string]int64{MetricTimersActive: 1})
+	suite.True(t.Reset(time.Minute))
+	suite.requireValues(map[string]int64{MetricTimersActive: 1})
+
+	suite.True(t.Stop())
+	suite.False(t.Stop())
+	suite.requireValues(map[string]int64{
+		MetricTimersCreated: 1,
+		MetricTimersStopped: 1,
+		MetricTimersFired:   1,
+		MetricTimersActive:  0,
+	})
+}
+
+func (suite *MetricsClockSuite) TestAfter() {
+	ch := suite.mc.After(time.Second)
+	suite.requireNoSignal(ch, Immediate)
+
+	suite.fc.Add(time.Second)
+	suite.requireSignal(ch, Immediate)
+	suite.requireValues(map[string]int64{MetricTimersCreated: 1, MetricTimersFired: 1, MetricTimersActive: 0})
+	suite.Equal([]time.Duration{0}, suite.sink.observed(MetricTimerLateness))
+}
+
+func (suite *MetricsClockSuite) TestAfterFunc() {
+	var called int
+	t := suite.mc.AfterFunc(time.Second, func() { called++ })
+	suite.Nil(t.C())
+
+	suite.fc.Add(time.Second)
+	suite.Equal(1, called)
+	suite.requireValues(map[string]int64{MetricTimersFired: 1, MetricTimersActive: 0})
+
+	// a nonpositive duration fires immediately
+	suite.mc.AfterFunc(0, func() { called++ })
+	suite.Equal(2, called)
+	suite.requireValues(map[string]int64{MetricTimersCreated: 2, MetricTimersFired: 2, MetricTimersActive: 0})
+}
+
+func (suite *MetricsClockSuite) TestNewTicker() {
+	suite.Panics(func() { suite.mc.NewTicker(0) })
+
+	t := suite.mc.NewTicker(time.Second)
+	suite.requireValues(map[string]int64{MetricTickersCreated: 1, MetricTickersActive: 1})
+
+	suite.fc.Add(time.Second)
+	suite.requireReceiveEqual(t.C(), suite.now.Add(time.Second), Immediate)
+
+	// a late tick skips the ticks that were missed
+	suite.fc.Add(2*time.Second + 500*time.Millisecond)
+	suite.requireReceiveEqual(t.C(), suite.now.Add(3500*time.Millisecond), Immediate)
+	suite.fc.Add(500 * time.Millisecond)
+	suite.requireReceiveEqual(t.C(), suite.now.Add(4*time.Second), Immediate)
+	suite.requireValues(map[string]int64{MetricTicksFired: 3})
+	suite.Equal(
+		[]time.Duration{0, 1500 * time.Millisecond, 0},
+		suite.sink.observed(MetricTickLateness),
+	)
+
+	t.Reset(time.Minute)
+	suite.fc.Add(time.Second)
+	suite.requireNoSignal(t.C(), Immediate)
+	suite.fc.Add(time.Minute)
+	suite.requireSignal(t.C(), Immediate)
+
+	t.Stop()
+	t.Stop()
+	suite.requireValues(map[string]int64{MetricTickersStopped: 1, MetricTickersActive: 0})
+	suite.fc.Add(time.Hour)
+	suite.requireNoSignal(t.C(), Immediate)
+
+	t.Reset(time.Second)
+	suite.requireValues(map[string]int64{MetricTickersActive: 1})
+	suite.Panics(func() { t.Reset(-1) })
+	t.Stop()
+}
+
+func (suite *MetricsClockSuite) TestTick() {
+	suite.Nil(suite.mc.Tick(0))
+	ch := suite.mc.Tick(time.Second)
+	suite.fc.Add(time.Second)
+	suite.requireSignal(ch, Immediate)
+	suite.requireValues(map[string]int64{MetricTickersCreated: 1, MetricTickersActive: 1, MetricTicksFired: 1})
+}
+
+func (suite *MetricsClockSuite) TestSleep() {
+	onSleep := make(chan Sleeper, 1)
+	suite.fc.NotifyOnSleep(onSleep)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		suite.mc.Sleep(time.Second)
+	}()
+
+	suite.requireReceive(onSleep, WaitALittle)
+	suite.requireValues(map[string]int64{MetricSleepsStarted: 1, MetricSleepsActive: 1})
+
+	suite.fc.Add(2 * time.Second)
+	suite.requireSignal(done, WaitALittle)
+	suite.requireValues(map[string]int64{MetricSleepsStarted: 1, MetricSleepsActive: 0})
+	suite.Equal([]time.Duration{2 * time.Second}, suite.sink.observed(MetricSleepDuration))
+}
+
+func (suite *MetricsClockSuite) TestExpvarMetricsSink() {
+	m := new(expvar.Map)
+	sink := NewExpvarMetricsSink(m)
+	sink.Add(MetricTimersActive, 2)
+	sink.Add(MetricTimersActive, -1)
+	sink.Observe(MetricTimerLateness, 3)
+	sink.Observe(MetricTimerLateness, 1)
+
+	var metrics map[string]any
+	suite.Require().NoError(json.Unmarshal([]byte(m.String()), &metrics))
+	suite.Equal(
+		map[string]any{
+			MetricTimersActive: 1.0,
+			MetricTimerLateness: map[string]any{
+				"count": 2.0,
+				"total": 4.0,
+				"max":   3.0,
+			},
+		},
+		metrics,
+	)
+}
+
+func TestMetricsClock(t *testing.T) {
+	suite.Run(t, new(MetricsClockSuite))
+}
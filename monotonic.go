@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"time"
+	"unsafe"
+)
+
+// timeLayout mirrors the internal layout of time.Time.  The time package offers
+// no way to construct a time.Time with an arbitrary monotonic clock reading, which
+// is necessary for a fake clock whose wall time moves independently of its
+// monotonic time.
+type timeLayout struct {
+	wall uint64
+	ext  int64
+	loc  *time.Location
+}
+
+const (
+	hasMonotonic = 1 << 63
+	nsecShift    = 30
+
+	// minWall and maxWall are the range of wall seconds, measured from
+	// January 1 of year 1, that time.Time can store alongside a monotonic reading.
+	minWall int64 = (1884*365 + 1884/4 - 1884/100 + 1884/400) * 24 * 60 * 60
+	maxWall int64 = minWall + (1<<33 - 1)
+)
+
+// monotonicSupported indicates whether timeLayout matches the running version of
+// the time package.  If it does not, monotonic readings are never synthesized.
+// FakeClock.TracksMonotonic exposes this to callers.
+var monotonicSupported = checkMonotonic()
+
+// checkMonotonic verifies that timeLayout can read and write a monotonic clock reading
+// in a time.Time produced by the running version of the time package.
+func checkMonotonic() bool {
+	if unsafe.Sizeof(time.Time{}) != unsafe.Sizeof(timeLayout{}) {
+		return false
+	}
+
+	now := time.Now()
+	m, ok := readMonotonic(now)
+	if !ok {
+		return false
+	}
+
+	synthetic := setMonotonic(now.Round(0), m)
+	return synthetic == now
+}
+
+// readMonotonic extracts the monotonic clock reading from t.  If t
+// does not have a monotonic clock reading, this function returns false.
+func readMonotonic(t time.Time) (int64, bool) {
+	tl := (*timeLayout)(unsafe.Pointer(&t)) // nolint:gosec
+	if tl.wall&hasMonotonic == 0 {
+		return 0, false
+	}
+
+	return tl.ext, true
+}
+
+// setMonotonic returns a copy of t with the given monotonic clock reading.  The
+// wall time of t is unaffected.  If t's wall time is outside the range that
+// time.Time supports for monotonic readings, t is returned without one.
+func setMonotonic(t time.Time, m int64) time.Time {
+	t = t.Round(0)
+	tl := (*timeLayout)(unsafe.Pointer(&t)) // nolint:gosec
+
+	sec := tl.ext
+	if sec < minWall || maxWall < sec {
+		return t
+	}
+
+	tl.wall |= hasMonotonic | uint64(sec-minWall)<<nsecShift
+	tl.ext = m
+	return t
+}
+
+// hasMonotonicReading tests if t carries a monotonic clock reading, as is
+// the case for values returned by time.Now.
+func hasMonotonicReading(t time.Time) bool {
+	return t != t.Round(0)
+}
+
+// withWall returns a time with the given wall time and the monotonic clock reading
+// of m, if any.  If m has no monotonic clock reading, wall is returned as is.
+func withWall(wall, m time.Time) time.Time {
+	if !monotonicSupported {
+		return wall
+	}
+
+	if mono, ok := readMonotonic(m); ok {
+		return setMonotonic(wall, mono)
+	}
+
+	return wall
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MonotonicSuite struct {
+	ChrononSuite
+}
+
+func (suite *MonotonicSuite) TestCheckMonotonic() {
+	// a failure here means the layout of time.Time changed, and FakeClock
+	// silently stops tracking monotonic time
+	suite.Require().True(checkMonotonic(), "timeLayout does not match time.Time in %s", runtime.Version())
+	suite.True(monotonicSupported)
+}
+
+func (suite *MonotonicSuite) TestTracksMonotonic() {
+	suite.True(NewFakeClock(time.Now()).TracksMonotonic())
+	suite.False(NewFakeClock(time.Now().Round(0)).TracksMonotonic())
+	suite.False(NewFakeClock(time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)).TracksMonotonic())
+}
+
+func (suite *MonotonicSuite) TestReadSetMonotonic() {
+	m, ok := readMonotonic(suite.now)
+	suite.Require().True(ok)
+
+	wall := suite.now.Round(0).Add(time.Hour)
+	_, ok = readMonotonic(wall)
+	suite.False(ok)
+
+	t := setMonotonic(wall, m)
+	suite.True(hasMonotonicReading(t))
+	suite.True(wall.Equal(t.Round(0)))
+	suite.Zero(t.Sub(suite.now))
+	suite.Equal(time.Hour, t.Round(0).Sub(suite.now.Round(0)))
+}
+
+func (suite *MonotonicSuite) TestSetMonotonicOutOfRange() {
+	t := setMonotonic(time.Time{}, 123)
+	suite.False(hasMonotonicReading(t))
+	suite.True(t.IsZero())
+}
+
+func (suite *MonotonicSuite) TestWithWall() {
+	wall := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	suite.Equal(wall, withWall(wall, wall.Add(time.Hour)))
+
+	t := withWall(wall, suite.now)
+	suite.True(wall.Equal(t))
+	suite.Zero(t.Sub(suite.now))
+}
+
+func TestMonotonic(t *testing.T) {
+	suite.Run(t, new(MonotonicSuite))
+}
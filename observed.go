@@ -0,0 +1,223 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// observedTimer is a Timer driven by AfterFunc on a base clock.  This allows a
+// decorator to observe when the timer fires, including timers that send on a channel.
+type observedTimer struct {
+	base  Clock
+	c     chan time.Time
+	f     func()
+	fired func(when, now time.Time)
+	timer Timer
+
+	lock sync.Mutex
+	when time.Time
+}
+
+// newObservedTimer creates a timer that either sends on c or invokes f when it fires.
+// The fired function is invoked first with the scheduled and actual firing times.
+func newObservedTimer(base Clock, d time.Duration, c chan time.Time, f func(), fired func(when, now time.Time)) *observedTimer {
+	ot := &observedTimer{
+		base:  base,
+		c:     c,
+		f:     f,
+		fired: fired,
+		when:  base.Now().Add(d),
+	}
+
+	ot.timer = base.AfterFunc(d, ot.fire)
+	return ot
+}
+
+// fire is invoked by the base clock when this timer fires.
+func (ot *observedTimer) fire() {
+	now := ot.base.Now()
+	ot.lock.Lock()
+	when := ot.when
+	ot.lock.Unlock()
+
+	ot.fired(when, now)
+	if ot.c != nil {
+		sendTime(ot.c, now)
+	} else {
+		ot.f()
+	}
+}
+
+// next returns the time at which this timer is scheduled to fire.
+func (ot *observedTimer) next() (when time.Time) {
+	ot.lock.Lock()
+	when = ot.when
+	ot.lock.Unlock()
+	return
+}
+
+func (ot *observedTimer) C() <-chan time.Time {
+	return ot.c
+}
+
+func (ot *observedTimer) Reset(d time.Duration) bool {
+	ot.lock.Lock()
+	ot.when = ot.base.Now().Add(d)
+	ot.lock.Unlock()
+
+	return ot.timer.Reset(d)
+}
+
+func (ot *observedTimer) Stop() bool {
+	return ot.timer.Stop()
+}
+
+// observedTicker is a Ticker driven by AfterFunc on a base clock, which allows a
+// decorator to observe each tick.  A generation number identifies the current
+// schedule, so that ticks from a schedule abandoned by Reset or Stop are discarded.
+type observedTicker struct {
+	base Clock
+	c    chan time.Time
+	tick func(when, now time.Time)
+
+	lock       sync.Mutex
+	generation uint64
+	stopped    bool
+	interval   time.Duration
+	when       time.Time
+	timer      Timer
+}
+
+// newObservedTicker creates a ticker with the given interval, which must be positive.  The
+// ticker sends on c, and its first tick fires after the given delay.  The tick function is
+// invoked with the scheduled and actual times of each tick.
+func newObservedTicker(base Clock, c chan time.Time, first, d time.Duration, tick func(when, now time.Time)) *observedTicker {
+	if d <= 0 {
+		// consistent with time.NewTicker
+		panic(errors.New("non-positive interval for NewTicker"))
+	}
+
+	ot := &observedTicker{
+		base: base,
+		c:    c,
+		tick: tick,
+	}
+
+	ot.start(first, d)
+	return ot
+}
+
+// start begins a new schedule with the given interval, with the first tick after the
+// given delay.  Any previous schedule is abandoned.  This method returns true if this
+// ticker had been stopped.
+func (ot *observedTicker) start(first, d time.Duration) (reactivated bool) {
+	ot.lock.Lock()
+	ot.generation++
+	generation, old := ot.generation, ot.timer
+	reactivated = ot.stopped
+	ot.stopped = false
+	ot.interval = d
+	ot.when = ot.base.Now().Add(first)
+	ot.timer = nil
+	ot.lock.Unlock()
+
+	if old != nil {
+		old.Stop()
+	}
+
+	ot.schedule(generation, first)
+	return
+}
+
+// next returns the time of the next tick along with the interval.
+func (ot *observedTicker) next() (when time.Time, interval time.Duration) {
+	ot.lock.Lock()
+	when, interval = ot.when, ot.interval
+	ot.lock.Unlock()
+	return
+}
+
+// schedule arranges for the next tick of the given generation.  The lock must not be
+// held here, since a clock may invoke the function immediately.
+func (ot *observedTicker) schedule(generation uint64, d time.Duration) {
+	t := ot.base.AfterFunc(d, func() { ot.fire(generation) })
+
+	ot.lock.Lock()
+	if generation == ot.generation && !ot.stopped {
+		ot.timer = t
+		t = nil
+	}
+
+	ot.lock.Unlock()
+	if t != nil {
+		t.Stop()
+	}
+}
+
+// fire sends a tick and schedules the next one.  Ticks that would have fired while
+// this tick was late are skipped, as with time.Ticker.
+func (ot *observedTicker) fire(generation uint64) {
+	now := ot.base.Now()
+
+	ot.lock.Lock()
+	if generation != ot.generation || ot.stopped {
+		ot.lock.Unlock()
+		return
+	}
+
+	when := ot.when
+	next := when.Add(ot.interval)
+	if !next.After(now) {
+		next = when.Add((now.Sub(when)/ot.interval + 1) * ot.interval)
+	}
+
+	ot.when = next
+	ot.lock.Unlock()
+
+	ot.tick(when, now)
+	sendTime(ot.c, now)
+
+	ot.schedule(generation, next.Sub(now))
+}
+
+// reset is the implementation of Reset.  This method returns true if this ticker had been stopped.
+func (ot *observedTicker) reset(d time.Duration) bool {
+	if d <= 0 {
+		// consistent with time.Ticker
+		panic(errors.New("non-positive interval for Ticker.Reset"))
+	}
+
+	return ot.start(d, d)
+}
+
+// stop is the implementation of Stop.  This method returns true if this ticker had been active.
+func (ot *observedTicker) stop() (stopped bool) {
+	ot.lock.Lock()
+	stopped = !ot.stopped
+	ot.stopped = true
+	t := ot.timer
+	ot.timer = nil
+	ot.lock.Unlock()
+
+	if t != nil {
+		t.Stop()
+	}
+
+	return
+}
+
+func (ot *observedTicker) C() <-chan time.Time {
+	return ot.c
+}
+
+func (ot *observedTicker) Reset(d time.Duration) {
+	ot.reset(d)
+}
+
+func (ot *observedTicker) Stop() {
+	ot.stop()
+}
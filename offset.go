@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import "time"
+
+// offsetClock is a Clock whose time differs from another Clock's by a fixed amount.
+type offsetClock struct {
+	base   Clock
+	offset time.Duration
+}
+
+// Offset returns a Clock whose time runs ahead of the given base Clock by d.  A negative
+// d runs behind.  Durations are unaffected, so timers and tickers fire when they would
+// on the base clock, but the times they send are shifted by d.  If base is nil,
+// SystemClock() is used.
+func Offset(base Clock, d time.Duration) Clock {
+	if base == nil {
+		base = SystemClock()
+	}
+
+	return offsetClock{
+		base:   base,
+		offset: d,
+	}
+}
+
+func (oc offsetClock) Now() time.Time {
+	return oc.base.Now().Add(oc.offset)
+}
+
+func (oc offsetClock) Since(t time.Time) time.Duration {
+	return oc.Now().Sub(t)
+}
+
+func (oc offsetClock) Until(t time.Time) time.Duration {
+	return t.Sub(oc.Now())
+}
+
+func (oc offsetClock) Sleep(d time.Duration) {
+	oc.base.Sleep(d)
+}
+
+func (oc offsetClock) After(d time.Duration) <-chan time.Time {
+	return oc.NewTimer(d).C()
+}
+
+func (oc offsetClock) AfterFunc(d time.Duration, f func()) Timer {
+	return oc.base.AfterFunc(d, f)
+}
+
+func (oc offsetClock) Tick(d time.Duration) <-chan time.Time {
+	if d <= 0 {
+		// consistent with time.Tick
+		return nil
+	}
+
+	return oc.NewTicker(d).C()
+}
+
+func (oc offsetClock) NewTicker(d time.Duration) Ticker {
+	return newObservedTicker(oc, make(chan time.Time, 1), d, d, unobserved)
+}
+
+func (oc offsetClock) NewTimer(d time.Duration) Timer {
+	return newObservedTimer(oc, d, make(chan time.Time, 1), nil, unobserved)
+}
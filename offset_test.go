@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type OffsetSuite struct {
+	ChrononSuite
+}
+
+func (suite *OffsetSuite) TestNow() {
+	fc := suite.newFakeClock()
+	c := Offset(fc, time.Hour)
+	suite.Equal(fc.Now().Add(time.Hour), c.Now())
+	suite.Equal(time.Hour, c.Since(fc.Now()))
+	suite.Equal(-time.Hour, c.Until(fc.Now()))
+
+	fc.Add(time.Minute)
+	suite.Equal(fc.Now().Add(time.Hour), c.Now())
+
+	behind := Offset(fc, -time.Hour)
+	suite.Equal(fc.Now().Add(-time.Hour), behind.Now())
+}
+
+func (suite *OffsetSuite) TestSystemClock() {
+	c := Offset(nil, time.Hour)
+	suite.WithinDuration(time.Now().Add(time.Hour), c.Now(), time.Second)
+	suite.requireSignal(c.After(time.Millisecond), WaitALittle)
+}
+
+func (suite *OffsetSuite) TestTimers() {
+	fc := suite.newFakeClock()
+	c := Offset(fc, time.Hour)
+
+	t := c.NewTimer(time.Second)
+	suite.requireNoSignal(t.C(), Immediate)
+	fc.Add(time.Second)
+	suite.requireReceiveEqual(t.C(), c.Now(), Immediate)
+	suite.False(t.Stop())
+
+	after := c.After(time.Second)
+	fc.Add(time.Second)
+	suite.requireReceiveEqual(after, c.Now(), Immediate)
+
+	called := false
+	f := c.AfterFunc(time.Second, func() { called = true })
+	suite.Nil(f.C())
+	fc.Add(time.Second)
+	suite.True(called)
+
+	done := make(chan struct{})
+	onSleep := make(chan Sleeper, 1)
+	fc.NotifyOnSleep(onSleep)
+	go func() {
+		defer close(done)
+		c.Sleep(time.Second)
+	}()
+
+	suite.requireReceive(onSleep, WaitALittle)
+	fc.Add(time.Second)
+	suite.requireSignal(done, WaitALittle)
+}
+
+func (suite *OffsetSuite) TestTickers() {
+	fc := suite.newFakeClock()
+	c := Offset(fc, -time.Hour)
+
+	suite.Nil(c.Tick(0))
+	suite.Panics(func() { c.NewTicker(0) })
+
+	ticker := c.NewTicker(time.Second)
+	for i := 0; i < 3; i++ {
+		fc.Add(time.Second)
+		suite.requireReceiveEqual(ticker.C(), c.Now(), Immediate)
+	}
+
+	ticker.Stop()
+	fc.Add(time.Second)
+	suite.requireNoSignal(ticker.C(), Immediate)
+
+	ch := c.Tick(time.Second)
+	fc.Add(time.Second)
+	suite.requireReceiveEqual(ch, c.Now(), Immediate)
+}
+
+func TestOffset(t *testing.T) {
+	suite.Run(t, new(OffsetSuite))
+}
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// Pending describes an active timer, ticker, or sleeper that is waiting on a FakeClock.
+type Pending struct {
+	// Kind is the type of object.  A FakeClock reports KindTimer, KindAfterFunc,
+	// KindTicker, or KindSleep.  Timers created with After are reported as KindTimer.
+	Kind Kind `json:"kind"`
+
+	// When is the next time at which this object fires.
+	When time.Time `json:"when"`
+
+	// Interval is the interval of a ticker.  This field is zero for other objects.
+	Interval time.Duration `json:"interval,omitempty"`
+}
+
+// pending returns the objects waiting on this clock, ordered by When.  This
+// method must be invoked under this clock's lock.
+func (fc *FakeClock) pending() []Pending {
+	p := make([]Pending, 0, len(fc.listeners))
+	for l := range fc.listeners {
+		ss, ok := l.(snapshotter)
+		if !ok {
+			continue
+		}
+
+		state := ss.snapshot()
+		next := Pending{
+			When:     state.when,
+			Interval: state.tick,
+		}
+
+		switch v := l.(type) {
+		case *fakeTimer:
+			next.Kind = KindTimer
+			if v.f != nil {
+				next.Kind = KindAfterFunc
+			}
+
+		case *fakeTicker:
+			next.Kind = KindTicker
+
+		case *sleeper:
+			next.Kind = KindSleep
+		}
+
+		p = append(p, next)
+	}
+
+	sort.SliceStable(p, func(i, j int) bool {
+		return p[i].When.Before(p[j].When)
+	})
+
+	return p
+}
+
+// Pending returns the timers, tickers, and sleepers currently waiting on this
+// clock, ordered by When.
+func (fc *FakeClock) Pending() []Pending {
+	fc.lock.RLock()
+	defer fc.lock.RUnlock()
+	return fc.pending()
+}
+
+// FireNext moves this clock's time forward to the earliest When of any pending timer,
+// ticker, or sleeper, which fires that object along with any others due at the same
+// time.  The new time is returned.  If nothing is pending, this method does nothing
+// and returns false.
+func (fc *FakeClock) FireNext() (now time.Time, ok bool) {
+	fc.lock.Lock()
+	defer fc.unlock()
+
+	now, ok = fc.next()
+	switch {
+	case !ok:
+		now = fc.now
+
+	case now.After(fc.now):
+		now = fc.applyLeap(fc.normalize(now))
+		fc.now = now
+		fc.listeners.onUpdate(now)
+
+	default:
+		now = fc.now
+	}
+
+	return
+}
+
+// WaitForPending blocks until at least n timers, tickers, or sleepers are waiting on
+// this clock, or until the context is canceled.  This is useful for test code that must
+// not advance this clock until code under test has started waiting on it.
+func (fc *FakeClock) WaitForPending(ctx context.Context, n int) error {
+	for {
+		fc.lock.Lock()
+		if len(fc.listeners) >= n {
+			fc.lock.Unlock()
+			return nil
+		}
+
+		if fc.changed == nil {
+			fc.changed = make(chan struct{})
+		}
+
+		changed := fc.changed
+		fc.lock.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PendingSuite struct {
+	ChrononSuite
+}
+
+func (suite *PendingSuite) TestPending() {
+	fc := suite.newFakeClock()
+	suite.Empty(fc.Pending())
+
+	fc.NewTicker(3 * time.Second)
+	fc.AfterFunc(2*time.Second, func() {})
+	t := fc.NewTimer(time.Second)
+	fc.NewTimer(0) // fires immediately, so is never pending
+
+	suite.Equal(
+		[]Pending{
+			{Kind: KindTimer, When: suite.now.Add(time.Second)},
+			{Kind: KindAfterFunc, When: suite.now.Add(2 * time.Second)},
+			{Kind: KindTicker, When: suite.now.Add(3 * time.Second), Interval: 3 * time.Second},
+		},
+		fc.Pending(),
+	)
+
+	t.Stop()
+	suite.Len(fc.Pending(), 2)
+}
+
+func (suite *PendingSuite) TestPendingSleep() {
+	s, fc, done := suite.newSleeper(time.Second)
+	suite.Equal([]Pending{{Kind: KindSleep, When: s.When()}}, fc.Pending())
+	s.Wakeup()
+	suite.requireSignal(done, WaitALittle)
+	suite.Empty(fc.Pending())
+}
+
+func (suite *PendingSuite) TestFireNext() {
+	fc := suite.newFakeClock()
+	now, ok := fc.FireNext()
+	suite.False(ok)
+	suite.Equal(suite.now, now)
+
+	t1 := fc.NewTimer(time.Second)
+	t2 := fc.NewTimer(time.Second)
+	t3 := fc.NewTimer(time.Minute)
+
+	now, ok = fc.FireNext()
+	suite.True(ok)
+	suite.Equal(suite.now.Add(time.Second), now)
+	suite.Equal(now, fc.Now())
+	suite.requireSignal(t1.C(), Immediate)
+	suite.requireSignal(t2.C(), Immediate)
+	suite.requireNoSignal(t3.C(), Immediate)
+
+	now, ok = fc.FireNext()
+	suite.True(ok)
+	suite.Equal(suite.now.Add(time.Minute), now)
+	suite.requireSignal(t3.C(), Immediate)
+}
+
+func (suite *PendingSuite) TestFireNextAt() {
+	loc, err := time.LoadLocation("America/New_York")
+	suite.Require().NoError(err)
+
+	fc := NewFakeClock(suite.now, WithLocation(loc))
+	t := fc.NewTimerAt(suite.now.Add(time.Minute).Round(0))
+
+	// the timer's time has no monotonic reading, but the clock keeps tracking monotonic time
+	now, ok := fc.FireNext()
+	suite.True(ok)
+	suite.True(suite.now.Add(time.Minute).Equal(now))
+	suite.Equal(now, fc.Now())
+	suite.True(hasMonotonicReading(now))
+	suite.Equal(loc, now.Location())
+	suite.requireSignal(t.C(), Immediate)
+
+	// so a wall clock step does not affect monotonic time
+	stepped := fc.StepWall(time.Hour)
+	suite.Equal(time.Hour, stepped.Round(0).Sub(now.Round(0)))
+	suite.Zero(stepped.Sub(now))
+}
+
+func (suite *PendingSuite) TestWaitForPending() {
+	fc := suite.newFakeClock()
+	suite.NoError(fc.WaitForPending(context.Background(), 0))
+
+	result := make(chan error)
+	go func() {
+		result <- fc.WaitForPending(context.Background(), 2)
+	}()
+
+	fc.NewTimer(time.Second)
+	suite.requireNoSignal(result, WaitALittle)
+	go fc.Sleep(time.Second)
+	suite.Nil(suite.requireReceive(result, WaitALittle))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		result <- fc.WaitForPending(ctx, 3)
+	}()
+
+	cancel()
+	suite.ErrorIs(suite.requireReceive(result, WaitALittle).(error), context.Canceled)
+}
+
+func TestPending(t *testing.T) {
+	suite.Run(t, new(PendingSuite))
+}
@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// PrecisionTick is a single tick sent by a PrecisionTicker.
+type PrecisionTick struct {
+	// N is the position of this tick on the ticker's timeline.  The first tick is 1.
+	// When ticks are missed, N skips ahead accordingly.
+	N uint64
+
+	// Scheduled is the time at which this tick was due, which is the ticker's start
+	// time plus N intervals.
+	Scheduled time.Time
+
+	// Actual is the clock time at which this tick was sent.
+	Actual time.Time
+
+	// Missed is the number of ticks skipped immediately before this one, because they
+	// were already overdue or because the receiver had not taken them by the next tick.
+	Missed uint64
+}
+
+// Lateness is how long after its scheduled time this tick was sent.
+func (pt PrecisionTick) Lateness() time.Duration {
+	return pt.Actual.Sub(pt.Scheduled)
+}
+
+// PrecisionTickerOption represents a configurable option for a PrecisionTicker.
+type PrecisionTickerOption func(*PrecisionTicker)
+
+// WithSpin makes a PrecisionTicker wake the given duration before each tick is due and
+// then busy-wait for the remainder.  This reduces jitter from timer latency at the cost of
+// CPU time.  Nonpositive values disable spinning, which is the default.
+//
+// With a *FakeClock, a spinning ticker waits until the fake clock reaches the tick's
+// scheduled time, so tests typically advance the clock by whole intervals.
+func WithSpin(d time.Duration) PrecisionTickerOption {
+	return func(pt *PrecisionTicker) {
+		pt.spin = d
+	}
+}
+
+// PrecisionTicker sends ticks paced against an absolute timeline.  Each tick is scheduled
+// at the ticker's start time plus a whole number of intervals, so timer latency on one tick
+// does not delay the ones after it and drift never accumulates.  This differs from a Ticker,
+// whose ticks can skew under load.
+//
+// Each tick reports its lateness and how many ticks were missed.  As with a time.Ticker,
+// C() buffers one tick.  A tick is missed when it is already overdue by a full interval,
+// because the system was loaded, or when it is still unreceived once the next tick is due.
+// In the latter case, the stale tick is replaced with the new one, so a slow receiver
+// causes missed ticks rather than a backlog, and a received tick is never more than one
+// interval old.
+type PrecisionTicker struct {
+	clock    ClockAt
+	interval time.Duration
+	spin     time.Duration
+	start    time.Time
+	c        chan PrecisionTick
+	missed   atomic.Uint64
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewPrecisionTicker starts a PrecisionTicker with the given interval on the given Clock.
+// The first tick is due one interval from now.  If c is nil, SystemClock() is used.  This
+// function panics if interval is not positive, consistent with time.NewTicker.
+func NewPrecisionTicker(c Clock, interval time.Duration, opts ...PrecisionTickerOption) *PrecisionTicker {
+	if interval <= 0 {
+		// consistent with time.NewTicker
+		panic(errors.New("non-positive interval for NewPrecisionTicker"))
+	}
+
+	if c == nil {
+		c = SystemClock()
+	}
+
+	pt := &PrecisionTicker{
+		clock:    At(c),
+		interval: interval,
+		c:        make(chan PrecisionTick, 1),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+
+	for _, o := range opts {
+		o(pt)
+	}
+
+	pt.start = pt.clock.Now()
+	go pt.run()
+	return pt
+}
+
+// C returns the channel on which ticks are sent.  This channel is never closed.
+func (pt *PrecisionTicker) C() <-chan PrecisionTick {
+	return pt.c
+}
+
+// Missed returns the total number of ticks missed so far.
+func (pt *PrecisionTicker) Missed() uint64 {
+	return pt.missed.Load()
+}
+
+// Stop halts this ticker.  No ticks are sent once this method returns.  This method
+// is idempotent.
+func (pt *PrecisionTicker) Stop() {
+	pt.stopOnce.Do(func() {
+		close(pt.stop)
+	})
+
+	<-pt.done
+}
+
+// scheduled returns the time at which the nth tick is due.
+func (pt *PrecisionTicker) scheduled(n uint64) time.Time {
+	return pt.start.Add(time.Duration(n) * pt.interval)
+}
+
+// wait blocks until the given time, spinning for the final stretch if configured.
+// This method returns false if this ticker was stopped.
+func (pt *PrecisionTicker) wait(scheduled time.Time) bool {
+	t := pt.clock.NewTimerAt(scheduled.Add(-pt.spin))
+	select {
+	case <-pt.stop:
+		t.Stop()
+		return false
+
+	case <-t.C():
+	}
+
+	for pt.clock.Until(scheduled) > 0 {
+		select {
+		case <-pt.stop:
+			return false
+
+		default:
+			runtime.Gosched()
+		}
+	}
+
+	return true
+}
+
+// run sends ticks until this ticker is stopped.
+func (pt *PrecisionTicker) run() {
+	defer close(pt.done)
+
+	for n := uint64(1); ; n++ {
+		if !pt.wait(pt.scheduled(n)) {
+			return
+		}
+
+		now := pt.clock.Now()
+		tick := PrecisionTick{
+			N: uint64(now.Sub(pt.start) / pt.interval),
+		}
+
+		// a late wakeup may have passed later ticks, in which case the latest one is sent
+		if tick.N > n {
+			tick.Missed = tick.N - n
+			pt.missed.Add(tick.Missed)
+			n = tick.N
+		} else {
+			tick.N = n
+		}
+
+		// a tick that is still unreceived is stale, so it is replaced
+		select {
+		case stale := <-pt.c:
+			tick.Missed += stale.Missed + 1
+			pt.missed.Add(1)
+
+		default:
+		}
+
+		tick.Scheduled = pt.scheduled(n)
+		tick.Actual = now
+
+		// this goroutine is the only sender, and the buffer is now empty
+		pt.c <- tick
+	}
+}
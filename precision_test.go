@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PrecisionTickerSuite struct {
+	ChrononSuite
+}
+
+// newPrecisionTicker starts a ticker on a fake clock and waits for it to begin waiting.
+func (suite *PrecisionTickerSuite) newPrecisionTicker(interval time.Duration, opts ...PrecisionTickerOption) (*PrecisionTicker, *FakeClock) {
+	fc := suite.newFakeClock()
+	pt := NewPrecisionTicker(fc, interval, opts...)
+	suite.Require().NotNil(pt)
+	suite.T().Cleanup(pt.Stop)
+
+	suite.waitForTimer(fc)
+	return pt, fc
+}
+
+// waitForTimer waits for the ticker to schedule its next wakeup.
+func (suite *PrecisionTickerSuite) waitForTimer(fc *FakeClock) {
+	suite.T().Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	suite.Require().NoError(fc.WaitForPending(ctx, 1))
+}
+
+func (suite *PrecisionTickerSuite) receive(pt *PrecisionTicker) PrecisionTick {
+	suite.T().Helper()
+	return suite.requireReceive(pt.C(), WaitALittle).(PrecisionTick)
+}
+
+func (suite *PrecisionTickerSuite) TestInvalidInterval() {
+	suite.Panics(func() { NewPrecisionTicker(nil, 0) })
+	suite.Panics(func() { NewPrecisionTicker(nil, -time.Second) })
+}
+
+func (suite *PrecisionTickerSuite) TestTimeline() {
+	pt, fc := suite.newPrecisionTicker(time.Second)
+	start := fc.Now()
+
+	for n := uint64(1); n <= 3; n++ {
+		scheduled := start.Add(time.Duration(n) * time.Second)
+		fc.Set(scheduled.Add(-time.Millisecond))
+		suite.requireNoSignal(pt.C(), Immediate)
+
+		// each tick is scheduled on the timeline, so lateness does not accumulate
+		fc.Set(scheduled.Add(100 * time.Millisecond))
+		tick := suite.receive(pt)
+		suite.Equal(n, tick.N)
+		suite.Equal(scheduled, tick.Scheduled)
+		suite.Equal(fc.Now(), tick.Actual)
+		suite.Equal(100*time.Millisecond, tick.Lateness())
+		suite.Zero(tick.Missed)
+		suite.waitForTimer(fc)
+	}
+
+	suite.Zero(pt.Missed())
+}
+
+func (suite *PrecisionTickerSuite) TestNoDrift() {
+	pt, fc := suite.newPrecisionTicker(time.Second)
+	start := fc.Now()
+
+	// late ticks do not delay later ones
+	fc.Add(1500 * time.Millisecond)
+	suite.Equal(500*time.Millisecond, suite.receive(pt).Lateness())
+
+	suite.waitForTimer(fc)
+	fc.Add(499 * time.Millisecond)
+	suite.requireNoSignal(pt.C(), Immediate)
+	fc.Add(time.Millisecond)
+	tick := suite.receive(pt)
+	suite.Equal(start.Add(2*time.Second), tick.Scheduled)
+	suite.Zero(tick.Lateness())
+}
+
+func (suite *PrecisionTickerSuite) TestMissed() {
+	pt, fc := suite.newPrecisionTicker(time.Second)
+	start := fc.Now()
+
+	fc.Add(3500 * time.Millisecond)
+	tick := suite.receive(pt)
+	suite.Equal(uint64(3), tick.N)
+	suite.Equal(uint64(2), tick.Missed)
+	suite.Equal(start.Add(3*time.Second), tick.Scheduled)
+	suite.Equal(500*time.Millisecond, tick.Lateness())
+	suite.Equal(uint64(2), pt.Missed())
+}
+
+func (suite *PrecisionTickerSuite) TestSlowReceiver() {
+	pt, fc := suite.newPrecisionTicker(time.Second)
+	start := fc.Now()
+
+	// the first tick waits in the channel's buffer
+	fc.Add(time.Second)
+	suite.waitForTimer(fc)
+	suite.Zero(pt.Missed())
+
+	// once the next tick is due, the stale tick is replaced
+	fc.Add(1500 * time.Millisecond)
+	suite.waitForTimer(fc)
+	tick := suite.receive(pt)
+	suite.Equal(uint64(2), tick.N)
+	suite.Equal(uint64(1), tick.Missed)
+	suite.Equal(start.Add(2*time.Second), tick.Scheduled)
+	suite.Equal(fc.Now(), tick.Actual)
+	suite.Equal(500*time.Millisecond, tick.Lateness())
+	suite.Equal(uint64(1), pt.Missed())
+	suite.requireNoSignal(pt.C(), Immediate)
+
+	// missed ticks accumulate across replacements
+	fc.Add(time.Second)
+	suite.waitForTimer(fc)
+	fc.Add(2 * time.Second)
+	suite.waitForTimer(fc)
+	tick = suite.receive(pt)
+	suite.Equal(uint64(5), tick.N)
+	suite.Equal(uint64(2), tick.Missed)
+	suite.Equal(uint64(3), pt.Missed())
+}
+
+func (suite *PrecisionTickerSuite) TestSpin() {
+	pt, fc := suite.newPrecisionTicker(time.Second, WithSpin(100*time.Millisecond))
+	start := fc.Now()
+
+	// the ticker wakes early, then spins until the tick is due
+	fc.Add(950 * time.Millisecond)
+	suite.requireNoSignal(pt.C(), WaitALittle)
+	fc.Add(50 * time.Millisecond)
+	tick := suite.receive(pt)
+	suite.Equal(start.Add(time.Second), tick.Scheduled)
+	suite.Zero(tick.Lateness())
+}
+
+func (suite *PrecisionTickerSuite) TestStop() {
+	pt, fc := suite.newPrecisionTicker(time.Second)
+	pt.Stop()
+	pt.Stop()
+
+	fc.Add(time.Hour)
+	suite.requireNoSignal(pt.C(), Immediate)
+	suite.Empty(fc.Pending())
+
+	// a spinning ticker can be stopped
+	pt, fc = suite.newPrecisionTicker(time.Second, WithSpin(500*time.Millisecond))
+	fc.Add(600 * time.Millisecond)
+	pt.Stop()
+	suite.requireNoSignal(pt.C(), Immediate)
+}
+
+func (suite *PrecisionTickerSuite) TestSystemClock() {
+	pt := NewPrecisionTicker(nil, 10*time.Millisecond, WithSpin(time.Millisecond))
+	defer pt.Stop()
+
+	first := suite.requireReceive(pt.C(), WaitALittle).(PrecisionTick)
+	second := suite.requireReceive(pt.C(), WaitALittle).(PrecisionTick)
+	suite.Equal(time.Duration(second.N-first.N)*10*time.Millisecond, second.Scheduled.Sub(first.Scheduled))
+	suite.GreaterOrEqual(second.Lateness(), time.Duration(0))
+}
+
+func TestPrecisionTicker(t *testing.T) {
+	suite.Run(t, new(PrecisionTickerSuite))
+}
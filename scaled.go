@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// scaledClock is a Clock whose time runs at a multiple of another Clock's rate.
+type scaledClock struct {
+	base   Clock
+	factor float64
+	start  time.Time
+}
+
+// Scaled returns a Clock whose time runs factor times as fast as the given base Clock.
+// For example, a factor of 60 makes a minute pass for every second of the base clock,
+// which is useful for soak tests and demos.  A factor less than 1 slows time down.
+//
+// The returned clock starts at the base clock's current time.  Durations passed to
+// timers, tickers, and Sleep are measured in scaled time, so a one minute timer on a
+// clock scaled by 60 fires after one second of base time.  If base is nil, SystemClock()
+// is used.  This function panics if factor is not positive.
+func Scaled(base Clock, factor float64) Clock {
+	if !(factor > 0) || math.IsInf(factor, 1) {
+		panic(errors.New("the scale factor must be positive and finite"))
+	}
+
+	if base == nil {
+		base = SystemClock()
+	}
+
+	return &scaledClock{
+		base:   base,
+		factor: factor,
+		start:  base.Now(),
+	}
+}
+
+// baseDuration converts a scaled duration into a duration on the base clock.  Positive
+// durations are rounded up, so that timers never fire before their scaled time.
+func (sc *scaledClock) baseDuration(d time.Duration) time.Duration {
+	if d <= 0 {
+		return d
+	}
+
+	return time.Duration(math.Ceil(float64(d) / sc.factor))
+}
+
+func (sc *scaledClock) Now() time.Time {
+	elapsed := sc.base.Since(sc.start)
+	return sc.start.Add(time.Duration(float64(elapsed) * sc.factor))
+}
+
+func (sc *scaledClock) Since(t time.Time) time.Duration {
+	return sc.Now().Sub(t)
+}
+
+func (sc *scaledClock) Until(t time.Time) time.Duration {
+	return t.Sub(sc.Now())
+}
+
+func (sc *scaledClock) Sleep(d time.Duration) {
+	sc.base.Sleep(sc.baseDuration(d))
+}
+
+func (sc *scaledClock) After(d time.Duration) <-chan time.Time {
+	return sc.NewTimer(d).C()
+}
+
+func (sc *scaledClock) AfterFunc(d time.Duration, f func()) Timer {
+	return scaledTimer{
+		Timer: sc.base.AfterFunc(sc.baseDuration(d), f),
+		sc:    sc,
+	}
+}
+
+func (sc *scaledClock) Tick(d time.Duration) <-chan time.Time {
+	if d <= 0 {
+		// consistent with time.Tick
+		return nil
+	}
+
+	return sc.NewTicker(d).C()
+}
+
+func (sc *scaledClock) NewTicker(d time.Duration) Ticker {
+	return newObservedTicker(sc, make(chan time.Time, 1), d, d, unobserved)
+}
+
+func (sc *scaledClock) NewTimer(d time.Duration) Timer {
+	return newObservedTimer(sc, d, make(chan time.Time, 1), nil, unobserved)
+}
+
+// scaledTimer is an AfterFunc Timer whose Reset durations are in scaled time.
+type scaledTimer struct {
+	Timer
+	sc *scaledClock
+}
+
+func (st scaledTimer) Reset(d time.Duration) bool {
+	return st.Timer.Reset(st.sc.baseDuration(d))
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ScaledSuite struct {
+	ChrononSuite
+}
+
+func (suite *ScaledSuite) TestInvalidFactor() {
+	for _, factor := range []float64{0, -1, math.NaN(), math.Inf(1)} {
+		suite.Panics(func() { Scaled(nil, factor) }, "factor: %v", factor)
+	}
+}
+
+func (suite *ScaledSuite) TestNow() {
+	fc := suite.newFakeClock()
+	c := Scaled(fc, 60)
+	suite.Equal(fc.Now(), c.Now())
+
+	start := c.Now()
+	fc.Add(time.Second)
+	suite.Equal(start.Add(time.Minute), c.Now())
+	suite.Equal(time.Minute, c.Since(start))
+	suite.Equal(time.Minute, c.Until(start.Add(2*time.Minute)))
+
+	slow := Scaled(fc, 0.5)
+	start = slow.Now()
+	fc.Add(time.Minute)
+	suite.Equal(start.Add(30*time.Second), slow.Now())
+}
+
+func (suite *ScaledSuite) TestSystemClock() {
+	c := Scaled(nil, 1000)
+	start := c.Now()
+	suite.requireSignal(c.After(time.Second), WaitALittle*5)
+	suite.GreaterOrEqual(c.Since(start), time.Second)
+}
+
+func (suite *ScaledSuite) TestTimers() {
+	fc := suite.newFakeClock()
+	c := Scaled(fc, 60)
+
+	t := c.NewTimer(time.Minute)
+	fc.Add(time.Second - time.Nanosecond)
+	suite.requireNoSignal(t.C(), Immediate)
+	fc.Add(time.Nanosecond)
+	suite.requireReceiveEqual(t.C(), c.Now(), Immediate)
+
+	suite.False(t.Reset(time.Hour))
+	fc.Add(30 * time.Second)
+	suite.requireNoSignal(t.C(), Immediate)
+	fc.Add(30 * time.Second)
+	suite.requireSignal(t.C(), Immediate)
+
+	called := false
+	f := c.AfterFunc(time.Minute, func() { called = true })
+	suite.Nil(f.C())
+	suite.True(f.Reset(2 * time.Minute))
+	fc.Add(time.Second)
+	suite.False(called)
+	fc.Add(time.Second)
+	suite.True(called)
+
+	done := make(chan struct{})
+	onSleep := make(chan Sleeper, 1)
+	fc.NotifyOnSleep(onSleep)
+	go func() {
+		defer close(done)
+		c.Sleep(time.Hour)
+	}()
+
+	suite.Equal(fc.Now().Add(time.Minute), suite.requireReceive(onSleep, WaitALittle).(Sleeper).When())
+	fc.Add(time.Minute)
+	suite.requireSignal(done, WaitALittle)
+}
+
+func (suite *ScaledSuite) TestTickers() {
+	fc := suite.newFakeClock()
+	c := Scaled(fc, 10)
+
+	suite.Nil(c.Tick(0))
+	suite.Panics(func() { c.NewTicker(0) })
+
+	ticker := c.NewTicker(time.Second)
+	for i := 0; i < 3; i++ {
+		fc.Add(99 * time.Millisecond)
+		suite.requireNoSignal(ticker.C(), Immediate)
+		fc.Add(time.Millisecond)
+		suite.requireReceiveEqual(ticker.C(), c.Now(), Immediate)
+	}
+
+	ticker.Reset(time.Minute)
+	fc.Add(time.Second)
+	suite.requireNoSignal(ticker.C(), Immediate)
+	fc.Add(5 * time.Second)
+	suite.requireSignal(ticker.C(), Immediate)
+
+	ticker.Stop()
+	fc.Add(time.Hour)
+	suite.requireNoSignal(ticker.C(), Immediate)
+}
+
+func TestScaled(t *testing.T) {
+	suite.Run(t, new(ScaledSuite))
+}
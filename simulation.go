@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"sync"
+	"time"
+)
+
+// simulationNode is a single clock participating in a Simulation.
+type simulationNode struct {
+	fc *FakeClock
+
+	// pausedUntil is the reference time at which a pause ends.  This
+	// field is the zero time if the node is not paused.
+	pausedUntil time.Time
+
+	// pending is the amount of reference time that has passed during
+	// the current pause.
+	pending time.Duration
+}
+
+// paused tests if this node is paused as of the given reference time.
+func (sn *simulationNode) paused(now time.Time) bool {
+	return !sn.pausedUntil.IsZero() && now.Before(sn.pausedUntil)
+}
+
+// Simulation is a master timeline that drives any number of FakeClock instances,
+// one per simulated node.  Each node's clock can have its own skew and drift, and
+// a node can be paused to simulate a stop-the-world GC pause or a VM suspend.
+//
+// Advancing a Simulation advances every node's clock consistently.  Timers, tickers,
+// and sleepers across all nodes are dispatched in the order in which they fire on the
+// reference timeline.  Objects that fire at the same reference time are dispatched in
+// the order their nodes were created.
+//
+// Code under test should only use its own node's clock.  Advancing a node's clock
+// directly, rather than through the Simulation, causes that node to run ahead of
+// the reference timeline.
+type Simulation struct {
+	lock  sync.Mutex
+	now   time.Time
+	nodes []*simulationNode
+}
+
+// NewSimulation creates a Simulation whose reference timeline begins at the given time.
+func NewSimulation(start time.Time) *Simulation {
+	return &Simulation{
+		now: start,
+	}
+}
+
+// Now returns the current time on the reference timeline.
+func (s *Simulation) Now() (now time.Time) {
+	s.lock.Lock()
+	now = s.now
+	s.lock.Unlock()
+	return
+}
+
+// NewClock creates a node clock that starts at this Simulation's current time.  Options
+// such as WithSkew and WithDrift establish how this node's clock disagrees with the
+// reference timeline.
+func (s *Simulation) NewClock(opts ...FakeClockOption) *FakeClock {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	fc := NewFakeClock(s.now, opts...)
+	s.nodes = append(s.nodes, &simulationNode{
+		fc: fc,
+	})
+
+	return fc
+}
+
+// Pause stops the given node's clock for the specified amount of reference time.  While
+// paused, the node's clock does not move and none of its timers, tickers, or sleepers fire.
+// When the pause ends, the node's clock catches up with the reference timeline all at once,
+// as happens when a process resumes after a GC pause or a VM suspend.
+//
+// Pausing a node that is already paused extends the pause if necessary.  This method
+// does nothing if the clock was not created by this Simulation or if d is nonpositive.
+func (s *Simulation) Pause(fc *FakeClock, d time.Duration) {
+	if d <= 0 {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, sn := range s.nodes {
+		if sn.fc == fc {
+			if until := s.now.Add(d); until.After(sn.pausedUntil) {
+				sn.pausedUntil = until
+			}
+
+			return
+		}
+	}
+}
+
+// Paused tests if the given node clock is currently paused.
+func (s *Simulation) Paused(fc *FakeClock) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, sn := range s.nodes {
+		if sn.fc == fc {
+			return sn.paused(s.now)
+		}
+	}
+
+	return false
+}
+
+// Add advances the reference timeline by the given duration and returns the new
+// reference time.  Rather than moving each node's clock in a single step, this method
+// moves all nodes forward together from one event to the next, so that timers fire
+// in a globally consistent order.  If d is nonpositive, this method does nothing.
+//
+// Add blocks while dispatching events, including any AfterFunc callbacks.  Callbacks
+// must not invoke methods on this Simulation.
+func (s *Simulation) Add(d time.Duration) time.Time {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	target := s.now.Add(d)
+	for s.now.Before(target) {
+		next := s.nextEvent(target)
+		step := next.Sub(s.now)
+		s.now = next
+
+		for _, sn := range s.nodes {
+			switch {
+			case sn.paused(s.now):
+				sn.pending += step
+
+			case !sn.pausedUntil.IsZero():
+				// the pause has just ended
+				sn.pausedUntil = time.Time{}
+				sn.fc.Add(sn.pending + step)
+				sn.pending = 0
+
+			default:
+				sn.fc.Add(step)
+			}
+		}
+	}
+
+	return s.now
+}
+
+// nextEvent returns the earliest reference time, no later than limit, at which
+// some node has an event.  Events include firing timers, tickers, and sleepers
+// as well as the end of a pause.
+func (s *Simulation) nextEvent(limit time.Time) time.Time {
+	next := limit
+	for _, sn := range s.nodes {
+		var candidate time.Time
+		if sn.paused(s.now) {
+			candidate = sn.pausedUntil
+		} else if when, ok := s.nodeEvent(sn.fc); ok {
+			candidate = when
+		} else {
+			continue
+		}
+
+		if candidate.Before(next) {
+			next = candidate
+		}
+	}
+
+	// always make progress, even if rounding leaves an event slightly in the past
+	if !next.After(s.now) {
+		next = s.now.Add(1)
+	}
+
+	return next
+}
+
+// nodeEvent computes the reference time at which the given node clock's earliest
+// event fires.
+func (s *Simulation) nodeEvent(fc *FakeClock) (time.Time, bool) {
+	fc.lock.RLock()
+	defer fc.lock.RUnlock()
+
+	when, ok := fc.next()
+	if !ok {
+		return time.Time{}, false
+	}
+
+	return s.now.Add(fc.referenceDuration(when.Sub(fc.now))), true
+}
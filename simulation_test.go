@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SimulationSuite struct {
+	ChrononSuite
+}
+
+func (suite *SimulationSuite) newSimulation() *Simulation {
+	s := NewSimulation(suite.now)
+	suite.Require().NotNil(s)
+	suite.Require().Equal(suite.now, s.Now())
+	return s
+}
+
+func (suite *SimulationSuite) TestAdd() {
+	s := suite.newSimulation()
+	fast := s.NewClock(WithDrift(1000))
+	slow := s.NewClock(WithSkew(-time.Minute))
+
+	suite.Equal(suite.now, fast.Now())
+	suite.Equal(-time.Minute, slow.Now().Round(0).Sub(suite.now.Round(0)))
+
+	now := s.Add(time.Second)
+	suite.Equal(suite.now.Add(time.Second), now)
+	suite.Equal(now, s.Now())
+	suite.Equal(time.Second+time.Millisecond, fast.Since(suite.now))
+	suite.Equal(time.Second, slow.Since(suite.now))
+
+	// nonpositive durations have no effect
+	suite.Equal(now, s.Add(0))
+	suite.Equal(now, s.Add(-time.Hour))
+}
+
+func (suite *SimulationSuite) TestOrdering() {
+	var (
+		s     = suite.newSimulation()
+		fast  = s.NewClock(WithDrift(100000)) // 10% fast
+		slow  = s.NewClock()
+		order []string
+		fired []time.Time
+	)
+
+	// each callback observes its own clock, which must not deadlock
+	slow.AfterFunc(time.Second, func() {
+		order = append(order, "slow")
+		fired = append(fired, slow.Now())
+	})
+
+	fast.AfterFunc(time.Second, func() {
+		order = append(order, "fast")
+		fired = append(fired, fast.Now())
+	})
+
+	s.Add(time.Hour)
+	suite.Equal([]string{"fast", "slow"}, order)
+	suite.Require().Len(fired, 2)
+
+	// each callback fired when its local clock reached the deadline, give or
+	// take a nanosecond of rounding due to drift
+	suite.InDelta(time.Second, fired[0].Sub(suite.now), 1)
+	suite.Equal(time.Second, fired[1].Sub(suite.now))
+}
+
+func (suite *SimulationSuite) TestPause() {
+	var (
+		s      = suite.newSimulation()
+		leader = s.NewClock()
+		other  = s.NewClock()
+
+		leaderFired bool
+		otherFired  bool
+	)
+
+	leader.AfterFunc(time.Second, func() { leaderFired = true })
+	other.AfterFunc(time.Second, func() { otherFired = true })
+
+	s.Pause(leader, 5*time.Second)
+	s.Pause(leader, time.Second) // does not shorten the pause
+	s.Pause(leader, -time.Second)
+	s.Pause(NewFakeClock(suite.now), time.Second)
+	suite.True(s.Paused(leader))
+	suite.False(s.Paused(other))
+	suite.False(s.Paused(NewFakeClock(suite.now)))
+
+	s.Add(2 * time.Second)
+	suite.True(otherFired)
+	suite.False(leaderFired)
+	suite.Equal(suite.now, leader.Now())
+	suite.True(s.Paused(leader))
+
+	s.Add(3 * time.Second)
+	suite.True(leaderFired)
+	suite.False(s.Paused(leader))
+	suite.Equal(5*time.Second, leader.Since(suite.now))
+	suite.Equal(5*time.Second, other.Since(suite.now))
+}
+
+func TestSimulation(t *testing.T) {
+	suite.Run(t, new(SimulationSuite))
+}
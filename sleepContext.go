@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"context"
+	"time"
+)
+
+// ContextSleeper is implemented by Clocks that natively support sleeping
+// with a context.  *FakeClock implements this interface.
+type ContextSleeper interface {
+	// SleepContext blocks until either the given duration elapses or the
+	// context is canceled.  This method returns nil if the sleep completed,
+	// or ctx.Err() if the sleep was interrupted.
+	SleepContext(context.Context, time.Duration) error
+}
+
+// SleepContext blocks until the given Clock believes that the duration has elapsed or
+// until the context is canceled, whichever happens first.  This function returns nil
+// if the sleep completed, or ctx.Err() if the context was canceled.  If the context is
+// already canceled, this function returns ctx.Err() immediately.
+//
+// If clock implements ContextSleeper, that implementation is used.  Otherwise, the sleep
+// is implemented with a Timer from the clock.
+func SleepContext(ctx context.Context, clock Clock, d time.Duration) error {
+	if cs, ok := clock.(ContextSleeper); ok {
+		return cs.SleepContext(ctx, d)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if d <= 0 {
+		return nil
+	}
+
+	t := clock.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C():
+		return nil
+
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// SleepUntil is like SleepContext, except that it sleeps until the given Clock
+// reaches an absolute time.  For a *FakeClock, the Sleeper's When is exactly t.
+func SleepUntil(ctx context.Context, clock Clock, t time.Time) error {
+	if fc, ok := clock.(*FakeClock); ok {
+		return fc.sleepContext(ctx, at(t))
+	}
+
+	return SleepContext(ctx, clock, clock.Until(t))
+}
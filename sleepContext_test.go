@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SleepContextSuite struct {
+	ChrononSuite
+}
+
+// sleep spawns a goroutine that invokes the given sleep function and returns
+// a channel that receives its result.
+func (suite *SleepContextSuite) sleep(f func() error) <-chan error {
+	result := make(chan error, 1)
+	go func() {
+		result <- f()
+	}()
+
+	return result
+}
+
+// startSleep spawns a SleepContext call against a FakeClock and waits for the Sleeper.
+func (suite *SleepContextSuite) startSleep(ctx context.Context, fc *FakeClock, f func() error) (Sleeper, <-chan error) {
+	onSleep := make(chan Sleeper, 1)
+	fc.NotifyOnSleep(onSleep)
+	defer fc.StopOnSleep(onSleep)
+
+	result := suite.sleep(f)
+	return suite.requireReceive(onSleep, WaitALittle).(Sleeper), result
+}
+
+func (suite *SleepContextSuite) TestFakeClockCompleted() {
+	fc := suite.newFakeClock()
+	ctx := context.Background()
+
+	s, result := suite.startSleep(ctx, fc, func() error {
+		return SleepContext(ctx, fc, TestInterval)
+	})
+
+	suite.Equal(TestInterval, fc.Until(s.When()))
+	suite.requireNoSignal(result, Immediate)
+
+	fc.Add(TestInterval)
+	suite.requireReceiveEqual(result, nil, WaitALittle)
+}
+
+func (suite *SleepContextSuite) TestFakeClockCanceled() {
+	fc := suite.newFakeClock()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s, result := suite.startSleep(ctx, fc, func() error {
+		return SleepContext(ctx, fc, TestInterval)
+	})
+
+	suite.requireNoSignal(result, Immediate)
+	cancel()
+	suite.requireReceiveEqual(result, context.Canceled, WaitALittle)
+
+	// the sleeper has been awakened and removed from the clock
+	suite.False(s.Wakeup())
+	fc.doWith(func(_ time.Time, ls *listeners) {
+		suite.False(ls.active(s.(*sleeper)))
+	})
+}
+
+func (suite *SleepContextSuite) TestFakeClockAlreadyCanceled() {
+	fc := suite.newFakeClock()
+	onSleep := make(chan Sleeper, 1)
+	fc.NotifyOnSleep(onSleep)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	suite.Equal(context.Canceled, SleepContext(ctx, fc, TestInterval))
+	suite.requireNoSignal(onSleep, Immediate)
+}
+
+func (suite *SleepContextSuite) TestSleepUntil() {
+	fc := suite.newFakeClock()
+	ctx := context.Background()
+
+	s, result := suite.startSleep(ctx, fc, func() error {
+		return SleepUntil(ctx, fc, suite.now.Add(TestInterval))
+	})
+
+	suite.Equal(suite.now.Add(TestInterval), s.When())
+	fc.Set(s.When())
+	suite.requireReceiveEqual(result, nil, WaitALittle)
+}
+
+func (suite *SleepContextSuite) TestSystemClock() {
+	clock := SystemClock()
+
+	suite.Run("Completed", func() {
+		suite.NoError(SleepContext(context.Background(), clock, 10*time.Millisecond))
+		suite.NoError(SleepContext(context.Background(), clock, 0))
+	})
+
+	suite.Run("Canceled", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		result := suite.sleep(func() error {
+			return SleepContext(ctx, clock, time.Hour)
+		})
+
+		cancel()
+		suite.requireReceiveEqual(result, context.Canceled, WaitALittle)
+	})
+
+	suite.Run("AlreadyCanceled", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		suite.Equal(context.Canceled, SleepContext(ctx, clock, 0))
+	})
+}
+
+func TestSleepContext(t *testing.T) {
+	suite.Run(t, new(SleepContextSuite))
+}
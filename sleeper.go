@@ -33,10 +33,16 @@ type sleeper struct {
 
 	once   sync.Once
 	awaken chan struct{}
-	when   time.Time
+
+	// lock guards when separately from the clock's lock, which is held
+	// while Sleep notifies channels registered with NotifyOnSleep.
+	lock sync.Mutex
+	when time.Time
 }
 
 func (s *sleeper) When() time.Time {
+	s.lock.Lock()
+	defer s.lock.Unlock()
 	return s.when
 }
 
@@ -73,7 +79,7 @@ func newSleeperAt(fc *FakeClock, when time.Time) *sleeper {
 // This method guards against multiple triggers, as might be the case
 // when concurrent FakeClock updates occur.
 func (s *sleeper) onUpdate(newNow time.Time) updateResult {
-	if equalOrAfter(newNow, s.when) {
+	if equalOrAfter(newNow, s.When()) {
 		s.once.Do(func() {
 			close(s.awaken)
 		})
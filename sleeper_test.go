@@ -74,6 +74,42 @@ func (suite *SleeperSuite) TestSet() {
 	suite.False(s.Wakeup())
 }
 
+func (suite *SleeperSuite) TestWhenWhileNotifying() {
+	var (
+		fc     = suite.newFakeClock()
+		first  = make(chan Sleeper)
+		second = make(chan Sleeper)
+		done   = make(chan struct{})
+	)
+
+	fc.NotifyOnSleep(first)
+	fc.NotifyOnSleep(second)
+
+	go func() {
+		defer close(done)
+		fc.Sleep(TestInterval)
+	}()
+
+	// notifiers are unordered, so wait for whichever channel is sent to first
+	var s Sleeper
+	remaining := second
+	select {
+	case s = <-first:
+	case s = <-second:
+		remaining = first
+	case <-time.After(WaitALittle):
+		suite.Require().Fail("No Sleeper was dispatched")
+	}
+
+	// Sleep is still blocked sending to the remaining channel here
+	suite.Equal(TestInterval, s.When().Sub(suite.now))
+	suite.Same(s, suite.requireReceive(remaining, WaitALittle))
+	suite.requireNoSignal(done, Immediate)
+
+	fc.Add(TestInterval)
+	suite.requireSignal(done, WaitALittle)
+}
+
 func TestSleeper(t *testing.T) {
 	suite.Run(t, new(SleeperSuite))
 }
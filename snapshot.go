@@ -0,0 +1,219 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"errors"
+	"time"
+)
+
+// snapshotState is the saved state of a single object created through a FakeClock.
+type snapshotState struct {
+	when time.Time
+	tick time.Duration
+}
+
+// snapshotter is implemented by listeners whose state can be captured in
+// a Snapshot and later restored.  As with onUpdate, these methods are always
+// invoked under the containing FakeClock's lock.
+type snapshotter interface {
+	listener
+
+	// snapshot returns the current state of this object.
+	snapshot() snapshotState
+
+	// restore resets this object to a previously captured state.  The return
+	// value indicates whether this object can be reactivated.
+	restore(snapshotState) bool
+
+	// clone creates a copy of this object that belongs to the given FakeClock.
+	// If this object cannot be copied, this method returns nil.
+	clone(*FakeClock) listener
+}
+
+// Snapshot is the captured state of a FakeClock at a point in time.  A Snapshot
+// holds the clock's current time along with the When value, interval, and active state
+// of each timer, ticker, and sleeper created through that clock.
+//
+// A Snapshot can only be restored to the FakeClock that created it.
+type Snapshot struct {
+	fc         *FakeClock
+	now        time.Time
+	leapOffset time.Duration
+	entries    map[listener]snapshotState
+}
+
+// Now returns the FakeClock's current time when this Snapshot was taken.
+func (s *Snapshot) Now() time.Time {
+	return s.now
+}
+
+// Snapshot captures the current state of this FakeClock.  The returned Snapshot
+// can be passed to Restore any number of times.
+func (fc *FakeClock) Snapshot() *Snapshot {
+	fc.lock.Lock()
+	defer fc.lock.Unlock()
+
+	s := &Snapshot{
+		fc:         fc,
+		now:        fc.now,
+		leapOffset: fc.leapOffset,
+		entries:    make(map[listener]snapshotState, len(fc.listeners)),
+	}
+
+	for l := range fc.listeners {
+		if ss, ok := l.(snapshotter); ok {
+			s.entries[l] = ss.snapshot()
+		}
+	}
+
+	return s
+}
+
+// Restore resets this FakeClock to the state captured by the given Snapshot.
+// The current time is set to the Snapshot's time.  Timers and tickers that were
+// active when the Snapshot was taken are reactivated with their original When and
+// interval, while those that were not active are stopped.
+//
+// Sleepers require special handling, since each one represents a blocked goroutine.
+// A Sleeper that has already awakened cannot be put back to sleep, and a Sleeper
+// that was created after the Snapshot was taken is left in place so that its goroutine
+// is not stranded.
+//
+// This method panics if the Snapshot was not created by this FakeClock.
+func (fc *FakeClock) Restore(s *Snapshot) {
+	if s.fc != fc {
+		panic(errors.New("the snapshot was not taken from this FakeClock"))
+	}
+
+	fc.lock.Lock()
+	defer fc.unlock()
+
+	fc.now = s.now
+	fc.leapOffset = s.leapOffset
+	for l := range fc.listeners {
+		if _, isSleeper := l.(*sleeper); !isSleeper {
+			if _, saved := s.entries[l]; !saved {
+				fc.listeners.remove(l)
+			}
+		}
+	}
+
+	for l, state := range s.entries {
+		if l.(snapshotter).restore(state) {
+			fc.listeners.add(l)
+		}
+	}
+
+	fc.listeners.onUpdate(fc.now)
+}
+
+// Fork creates a copy of this FakeClock.  The returned clock starts at this clock's
+// current time and has the same skew, drift, location, and leap second.  Subsequent
+// changes to either clock, such as with Add or Set, have no effect on the other.
+//
+// A fork is only a partial copy, and most tests that branch into alternate futures
+// should use Snapshot and Restore on a single clock instead.  In particular:
+//
+//   - Only timers created with AfterFunc are copied.  Timers and tickers created with
+//     NewTimer, NewTicker, After, or Tick are not copied, since no code could receive
+//     from a copy's channel, so code that waits on channels has nothing pending on the fork.
+//   - A copied timer invokes the same function as the original.  That function still
+//     refers to whatever the original closure captured, typically the original clock.
+//   - Sleepers are not copied, since a goroutine can only sleep on one clock.
+//   - Channels registered for notifications are not copied.
+//
+// Fork suits code driven entirely by AfterFunc callbacks that do not use the clock.
+func (fc *FakeClock) Fork() *FakeClock {
+	fc.lock.Lock()
+	defer fc.lock.Unlock()
+
+	fork := NewFakeClock(fc.now)
+	fork.skew = fc.skew
+	fork.drift = fc.drift
+	fork.loc = fc.loc
+	fork.leap = fc.leap
+	fork.leapOffset = fc.leapOffset
+	for l := range fc.listeners {
+		if ss, ok := l.(snapshotter); ok {
+			if c := ss.clone(fork); c != nil {
+				fork.listeners.add(c)
+			}
+		}
+	}
+
+	return fork
+}
+
+func (ft *fakeTimer) snapshot() snapshotState {
+	return snapshotState{
+		when: ft.when,
+	}
+}
+
+func (ft *fakeTimer) restore(state snapshotState) bool {
+	ft.when = state.when
+	if ft.c != nil {
+		// a fire after the snapshot never happened in the restored state
+		drainTime(ft.c)
+	}
+
+	return true
+}
+
+func (ft *fakeTimer) clone(fc *FakeClock) listener {
+	if ft.c != nil {
+		// nothing could receive from a copy's channel
+		return nil
+	}
+
+	return &fakeTimer{
+		fc:   fc,
+		f:    ft.f,
+		when: ft.when,
+	}
+}
+
+func (ft *fakeTicker) snapshot() snapshotState {
+	return snapshotState{
+		when: ft.next,
+		tick: ft.tick,
+	}
+}
+
+func (ft *fakeTicker) restore(state snapshotState) bool {
+	ft.next = state.when
+	ft.tick = state.tick
+	drainTime(ft.c)
+	return true
+}
+
+func (ft *fakeTicker) clone(*FakeClock) listener {
+	// nothing could receive from a copy's channel
+	return nil
+}
+
+func (s *sleeper) snapshot() snapshotState {
+	return snapshotState{
+		when: s.When(),
+	}
+}
+
+func (s *sleeper) restore(state snapshotState) bool {
+	select {
+	case <-s.awaken:
+		// a goroutine that has awakened cannot be put back to sleep
+		return false
+
+	default:
+		s.lock.Lock()
+		s.when = state.when
+		s.lock.Unlock()
+		return true
+	}
+}
+
+func (s *sleeper) clone(*FakeClock) listener {
+	return nil
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SnapshotSuite struct {
+	ChrononSuite
+}
+
+func (suite *SnapshotSuite) TestRestore() {
+	fc := suite.newFakeClock()
+	timer := fc.NewTimer(TestInterval).(FakeTimer)
+	ticker := fc.NewTicker(TestInterval).(FakeTicker)
+	stopped := fc.NewTimer(TestInterval).(FakeTimer)
+	suite.True(stopped.Stop())
+
+	s := fc.Snapshot()
+	suite.Require().NotNil(s)
+	suite.Equal(suite.now, s.Now())
+
+	// fire everything, then create a timer that isn't in the snapshot
+	fc.Add(TestInterval)
+	suite.requireSignal(timer.C(), Immediate)
+	suite.requireSignal(ticker.C(), Immediate)
+	suite.requireNoSignal(stopped.C(), Immediate)
+
+	ticker.Reset(2 * TestInterval)
+	later := fc.NewTimer(TestInterval).(FakeTimer)
+
+	fc.Restore(s)
+	suite.Equal(suite.now, fc.Now())
+	suite.Equal(suite.now.Add(TestInterval), timer.When())
+	suite.Equal(suite.now.Add(TestInterval), ticker.When())
+
+	// objects that were inactive when the snapshot was taken are deactivated
+	suite.False(later.Fire())
+	suite.False(stopped.Fire())
+
+	fc.Add(TestInterval)
+	suite.requireSignal(timer.C(), Immediate)
+	suite.requireSignal(ticker.C(), Immediate)
+	suite.requireNoSignal(stopped.C(), Immediate)
+	suite.requireNoSignal(later.C(), Immediate)
+
+	// a snapshot can be restored more than once
+	fc.Restore(s)
+	suite.Equal(suite.now, fc.Now())
+	suite.True(timer.Stop())
+}
+
+func (suite *SnapshotSuite) TestRestoreUndrained() {
+	fc := suite.newFakeClock()
+	timer := fc.NewTimer(TestInterval).(FakeTimer)
+	ticker := fc.NewTicker(TestInterval).(FakeTicker)
+	s := fc.Snapshot()
+
+	// fire everything, but leave the fires in the channels
+	fc.Add(TestInterval)
+	fc.Restore(s)
+	suite.Equal(suite.now.Add(TestInterval), timer.When())
+	suite.Equal(suite.now.Add(TestInterval), ticker.When())
+
+	// fires that happened after the snapshot are discarded
+	suite.requireNoSignal(timer.C(), Immediate)
+	suite.requireNoSignal(ticker.C(), Immediate)
+
+	fc.Add(TestInterval)
+	suite.requireSignal(timer.C(), Immediate)
+	suite.requireSignal(ticker.C(), Immediate)
+}
+
+func (suite *SnapshotSuite) TestRestoreSleeper() {
+	s, fc, done := suite.newSleeper(TestInterval)
+	snapshot := fc.Snapshot()
+
+	fc.Add(TestInterval / 2)
+	fc.Restore(snapshot)
+	suite.requireNoSignal(done, Immediate)
+	suite.Equal(TestInterval, fc.Until(s.When()))
+
+	fc.Add(TestInterval)
+	suite.requireSignal(done, WaitALittle)
+
+	// an awakened sleeper cannot be put back to sleep
+	fc.Restore(snapshot)
+	suite.False(s.Wakeup())
+}
+
+func (suite *SnapshotSuite) TestRestoreWrongClock() {
+	fc := suite.newFakeClock()
+	other := suite.newFakeClock()
+
+	suite.Panics(func() {
+		fc.Restore(other.Snapshot())
+	})
+}
+
+func (suite *SnapshotSuite) TestFork() {
+	fc := suite.newFakeClock()
+	timer := fc.NewTimer(TestInterval)
+	ticker := fc.NewTicker(TestInterval)
+
+	called := make(chan struct{}, 2)
+	fc.AfterFunc(TestInterval, func() { called <- struct{}{} })
+
+	fork := fc.Fork()
+	suite.Require().NotNil(fork)
+	suite.NotSame(fc, fork)
+	suite.Equal(fc.Now(), fork.Now())
+
+	// only the AfterFunc timer is copied
+	suite.Len(fc.Pending(), 3)
+	suite.Len(fork.Pending(), 1)
+
+	// advancing the fork has no effect on the original
+	fork.Add(TestInterval)
+	suite.requireSignal(called, Immediate)
+	suite.requireNoSignal(timer.C(), Immediate)
+	suite.requireNoSignal(ticker.C(), Immediate)
+	suite.Equal(suite.now, fc.Now())
+	suite.Empty(fork.Pending())
+
+	fc.Add(TestInterval)
+	suite.requireSignal(called, Immediate)
+	suite.requireSignal(timer.C(), Immediate)
+	suite.requireSignal(ticker.C(), Immediate)
+}
+
+func TestSnapshot(t *testing.T) {
+	suite.Run(t, new(SnapshotSuite))
+}
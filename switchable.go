@@ -0,0 +1,340 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// switchableTimerEntry is the current schedule for an active switchableTimer.
+type switchableTimerEntry struct {
+	ot *observedTimer
+}
+
+// switchableTickerEntry is the current schedule for an active switchableTicker.
+type switchableTickerEntry struct {
+	ot *observedTicker
+}
+
+// SwitchableClock is a Clock that can be switched at runtime between system time and
+// fake time.  It is intended for integration and end-to-end tests against a running
+// service: the service uses a SwitchableClock as its Clock, and a test switches it into
+// fake mode, typically through some control endpoint, to drive time deterministically.
+//
+// A SwitchableClock starts in system mode, where it delegates to SystemClock().  Calling
+// Fake switches to a *FakeClock that starts frozen at the current time.  Calling System
+// switches back.  Timers and tickers created through a SwitchableClock are migrated when
+// the mode changes: each is rescheduled on the new timeline with the same amount of time
+// remaining until it fires.  Goroutines blocked in Sleep are migrated the same way.
+//
+// AfterFunc callbacks must not call Fake or System.
+type SwitchableClock struct {
+	lock    sync.Mutex
+	fake    *FakeClock
+	timers  map[*switchableTimer]*switchableTimerEntry
+	tickers map[*switchableTicker]*switchableTickerEntry
+}
+
+var _ Clock = (*SwitchableClock)(nil)
+
+// NewSwitchableClock creates a SwitchableClock in system mode.
+func NewSwitchableClock() *SwitchableClock {
+	return &SwitchableClock{
+		timers:  make(map[*switchableTimer]*switchableTimerEntry),
+		tickers: make(map[*switchableTicker]*switchableTickerEntry),
+	}
+}
+
+// base returns the clock for the current mode.  This method must be invoked under the lock.
+func (sc *SwitchableClock) base() Clock {
+	if sc.fake != nil {
+		return sc.fake
+	}
+
+	return SystemClock()
+}
+
+// IsFake tests if this clock is currently in fake mode.
+func (sc *SwitchableClock) IsFake() (fake bool) {
+	sc.lock.Lock()
+	fake = sc.fake != nil
+	sc.lock.Unlock()
+	return
+}
+
+// Fake switches this clock into fake mode, if necessary, and returns the *FakeClock
+// that drives it.  Use the returned clock's Add and Set methods to move time.  When first
+// switched, the fake clock is frozen at the current system time.  If this clock is already
+// in fake mode, this method simply returns the current fake clock.
+func (sc *SwitchableClock) Fake() *FakeClock {
+	sc.lock.Lock()
+	if sc.fake != nil {
+		defer sc.lock.Unlock()
+		return sc.fake
+	}
+
+	fc := NewFakeClock(time.Now())
+	sc.unlockAndSwitch(fc)
+	return fc
+}
+
+// System switches this clock back to system time.  If this clock is already in system
+// mode, this method does nothing.  The fake clock used in fake mode is abandoned: it
+// can still be used, but it no longer affects this clock.
+func (sc *SwitchableClock) System() {
+	sc.lock.Lock()
+	if sc.fake == nil {
+		sc.lock.Unlock()
+		return
+	}
+
+	sc.unlockAndSwitch(nil)
+}
+
+// unlockAndSwitch changes modes, migrating each active timer and ticker to the new
+// mode's clock.  This method must be invoked under the lock, which it releases.
+//
+// Nothing is scheduled with a nonpositive duration while the lock is held, since a
+// FakeClock invokes such functions immediately.  Timers and ticks that are already
+// due are dispatched after the lock is released.
+func (sc *SwitchableClock) unlockAndSwitch(fake *FakeClock) {
+	var (
+		oldNow = sc.base().Now()
+		due    []*switchableTimer
+		ticked []*switchableTicker
+	)
+
+	sc.fake = fake
+	base := sc.base()
+	for st, e := range sc.timers {
+		delete(sc.timers, st)
+		if !e.ot.Stop() {
+			// the timer fired concurrently
+			continue
+		}
+
+		if remaining := e.ot.next().Sub(oldNow); remaining > 0 {
+			sc.scheduleTimer(base, st, remaining)
+		} else {
+			due = append(due, st)
+		}
+	}
+
+	for st, e := range sc.tickers {
+		when, interval := e.ot.next()
+		e.ot.stop()
+
+		remaining := when.Sub(oldNow)
+		if remaining <= 0 {
+			remaining = interval
+			ticked = append(ticked, st)
+		}
+
+		sc.tickers[st] = &switchableTickerEntry{
+			ot: newObservedTicker(base, st.c, remaining, interval, unobserved),
+		}
+	}
+
+	sc.lock.Unlock()
+
+	now := base.Now()
+	for _, st := range due {
+		newObservedTimer(base, 0, st.c, st.f, unobserved)
+	}
+
+	for _, st := range ticked {
+		sendTime(st.c, now)
+	}
+}
+
+// unobserved is the fired or tick function for timers and tickers that need no observation.
+func unobserved(time.Time, time.Time) {}
+
+// scheduleTimer schedules the given timer on a clock.  The lock must be held,
+// and d must be positive.
+func (sc *SwitchableClock) scheduleTimer(base Clock, st *switchableTimer, d time.Duration) {
+	e := new(switchableTimerEntry)
+	sc.timers[st] = e
+
+	// the fired function cannot run until the lock is released
+	e.ot = newObservedTimer(base, d, st.c, st.f, func(time.Time, time.Time) {
+		sc.lock.Lock()
+		if sc.timers[st] == e {
+			delete(sc.timers, st)
+		}
+
+		sc.lock.Unlock()
+	})
+}
+
+// startTimer schedules a timer on the current mode's clock.  This method returns
+// true if the timer had been active.
+func (sc *SwitchableClock) startTimer(st *switchableTimer, d time.Duration) bool {
+	sc.lock.Lock()
+	e := sc.timers[st]
+	delete(sc.timers, st)
+
+	active := e != nil && e.ot.Stop()
+	base := sc.base()
+	if d > 0 {
+		sc.scheduleTimer(base, st, d)
+		sc.lock.Unlock()
+	} else {
+		// the timer fires immediately, so there is nothing to migrate
+		sc.lock.Unlock()
+		newObservedTimer(base, d, st.c, st.f, unobserved)
+	}
+
+	return active
+}
+
+// stopTimer stops a timer, returning true if it had been active.
+func (sc *SwitchableClock) stopTimer(st *switchableTimer) bool {
+	sc.lock.Lock()
+	e := sc.timers[st]
+	delete(sc.timers, st)
+	sc.lock.Unlock()
+
+	return e != nil && e.ot.Stop()
+}
+
+// startTicker schedules a ticker on the current mode's clock.
+func (sc *SwitchableClock) startTicker(st *switchableTicker, d time.Duration) {
+	sc.lock.Lock()
+	defer sc.lock.Unlock()
+
+	if e := sc.tickers[st]; e != nil {
+		e.ot.reset(d)
+	} else {
+		sc.tickers[st] = &switchableTickerEntry{
+			ot: newObservedTicker(sc.base(), st.c, d, d, unobserved),
+		}
+	}
+}
+
+// stopTicker stops a ticker.
+func (sc *SwitchableClock) stopTicker(st *switchableTicker) {
+	sc.lock.Lock()
+	e := sc.tickers[st]
+	delete(sc.tickers, st)
+	sc.lock.Unlock()
+
+	if e != nil {
+		e.ot.stop()
+	}
+}
+
+func (sc *SwitchableClock) Now() time.Time {
+	sc.lock.Lock()
+	base := sc.base()
+	sc.lock.Unlock()
+
+	return base.Now()
+}
+
+func (sc *SwitchableClock) Since(t time.Time) time.Duration {
+	return sc.Now().Sub(t)
+}
+
+func (sc *SwitchableClock) Until(t time.Time) time.Duration {
+	return t.Sub(sc.Now())
+}
+
+// Sleep blocks until the given duration has elapsed.  If this clock changes modes
+// during the sleep, the remaining duration is measured on the new mode's clock.
+func (sc *SwitchableClock) Sleep(d time.Duration) {
+	<-sc.NewTimer(d).C()
+}
+
+func (sc *SwitchableClock) After(d time.Duration) <-chan time.Time {
+	return sc.NewTimer(d).C()
+}
+
+func (sc *SwitchableClock) AfterFunc(d time.Duration, f func()) Timer {
+	st := &switchableTimer{
+		sc: sc,
+		f:  f,
+	}
+
+	sc.startTimer(st, d)
+	return st
+}
+
+func (sc *SwitchableClock) Tick(d time.Duration) <-chan time.Time {
+	if d <= 0 {
+		// consistent with time.Tick
+		return nil
+	}
+
+	return sc.NewTicker(d).C()
+}
+
+func (sc *SwitchableClock) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		// consistent with time.NewTicker
+		panic(errors.New("non-positive interval for NewTicker"))
+	}
+
+	st := &switchableTicker{
+		sc: sc,
+		c:  make(chan time.Time, 1),
+	}
+
+	sc.startTicker(st, d)
+	return st
+}
+
+func (sc *SwitchableClock) NewTimer(d time.Duration) Timer {
+	st := &switchableTimer{
+		sc: sc,
+		c:  make(chan time.Time, 1),
+	}
+
+	sc.startTimer(st, d)
+	return st
+}
+
+// switchableTimer is a Timer created through a SwitchableClock.
+type switchableTimer struct {
+	sc *SwitchableClock
+	c  chan time.Time
+	f  func()
+}
+
+func (st *switchableTimer) C() <-chan time.Time {
+	return st.c
+}
+
+func (st *switchableTimer) Reset(d time.Duration) bool {
+	return st.sc.startTimer(st, d)
+}
+
+func (st *switchableTimer) Stop() bool {
+	return st.sc.stopTimer(st)
+}
+
+// switchableTicker is a Ticker created through a SwitchableClock.
+type switchableTicker struct {
+	sc *SwitchableClock
+	c  chan time.Time
+}
+
+func (st *switchableTicker) C() <-chan time.Time {
+	return st.c
+}
+
+func (st *switchableTicker) Reset(d time.Duration) {
+	if d <= 0 {
+		// consistent with time.Ticker
+		panic(errors.New("non-positive interval for Ticker.Reset"))
+	}
+
+	st.sc.startTicker(st, d)
+}
+
+func (st *switchableTicker) Stop() {
+	st.sc.stopTicker(st)
+}
@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SwitchableClockSuite struct {
+	ChrononSuite
+}
+
+func (suite *SwitchableClockSuite) newSwitchableClock() *SwitchableClock {
+	sc := NewSwitchableClock()
+	suite.Require().NotNil(sc)
+	suite.Require().False(sc.IsFake())
+	return sc
+}
+
+func (suite *SwitchableClockSuite) TestNow() {
+	sc := suite.newSwitchableClock()
+	before := time.Now()
+	suite.False(sc.Now().Before(before))
+
+	fc := sc.Fake()
+	suite.Require().NotNil(fc)
+	suite.True(sc.IsFake())
+	suite.Same(fc, sc.Fake())
+	suite.Equal(fc.Now(), sc.Now())
+
+	fc.Add(time.Hour)
+	suite.Equal(fc.Now(), sc.Now())
+	suite.Equal(time.Second, sc.Until(fc.Now().Add(time.Second)))
+	suite.Equal(time.Second, sc.Since(fc.Now().Add(-time.Second)))
+
+	sc.System()
+	suite.False(sc.IsFake())
+	sc.System()
+	suite.False(sc.IsFake())
+	suite.WithinDuration(time.Now(), sc.Now(), time.Second)
+	suite.NotSame(fc, sc.Fake())
+}
+
+func (suite *SwitchableClockSuite) TestSystemMode() {
+	sc := suite.newSwitchableClock()
+	t := sc.NewTimer(time.Millisecond)
+	suite.requireSignal(t.C(), WaitALittle)
+	suite.False(t.Stop())
+
+	called := make(chan struct{})
+	sc.AfterFunc(time.Millisecond, func() { close(called) })
+	suite.requireSignal(called, WaitALittle)
+
+	ticker := sc.NewTicker(time.Millisecond)
+	suite.requireSignal(ticker.C(), WaitALittle)
+	ticker.Stop()
+
+	sc.Sleep(time.Millisecond)
+	suite.requireSignal(sc.After(time.Millisecond), WaitALittle)
+}
+
+func (suite *SwitchableClockSuite) TestFakeMode() {
+	sc := suite.newSwitchableClock()
+	fc := sc.Fake()
+
+	t := sc.NewTimer(time.Second)
+	suite.requireNoSignal(t.C(), Immediate)
+	fc.Add(time.Second)
+	suite.requireReceiveEqual(t.C(), fc.Now(), Immediate)
+
+	suite.False(t.Reset(time.Second))
+	suite.True(t.Reset(time.Minute))
+	suite.True(t.Stop())
+	suite.False(t.Stop())
+	fc.Add(time.Hour)
+	suite.requireNoSignal(t.C(), Immediate)
+
+	called := 0
+	sc.AfterFunc(0, func() { called++ })
+	suite.Equal(1, called)
+
+	f := sc.AfterFunc(time.Second, func() { called++ })
+	suite.Nil(f.C())
+	fc.Add(time.Second)
+	suite.Equal(2, called)
+
+	suite.Nil(sc.Tick(0))
+	ch := sc.Tick(time.Second)
+	fc.Add(time.Second)
+	suite.requireSignal(ch, Immediate)
+
+	suite.Panics(func() { sc.NewTicker(0) })
+	ticker := sc.NewTicker(time.Second)
+	ticker.Reset(time.Minute)
+	fc.Add(time.Second)
+	suite.requireNoSignal(ticker.C(), Immediate)
+	fc.Add(time.Minute)
+	suite.requireSignal(ticker.C(), Immediate)
+
+	ticker.Stop()
+	fc.Add(time.Hour)
+	suite.requireNoSignal(ticker.C(), Immediate)
+	ticker.Reset(time.Second)
+	fc.Add(time.Second)
+	suite.requireSignal(ticker.C(), Immediate)
+	suite.Panics(func() { ticker.Reset(0) })
+	ticker.Stop()
+}
+
+func (suite *SwitchableClockSuite) TestMigrateToFake() {
+	var (
+		sc     = suite.newSwitchableClock()
+		timer  = sc.NewTimer(time.Hour)
+		ticker = sc.NewTicker(time.Hour)
+		done   = make(chan struct{})
+	)
+
+	go func() {
+		defer close(done)
+		sc.Sleep(time.Hour)
+	}()
+
+	// wait for the sleeping goroutine to create its timer
+	suite.Eventually(
+		func() bool {
+			sc.lock.Lock()
+			defer sc.lock.Unlock()
+			return len(sc.timers) == 2
+		},
+		time.Second,
+		time.Millisecond,
+	)
+
+	fc := sc.Fake()
+	fc.Add(time.Hour - time.Second)
+	suite.requireNoSignal(timer.C(), Immediate)
+	suite.requireNoSignal(ticker.C(), Immediate)
+	suite.requireNoSignal(done, Immediate)
+
+	fc.Add(time.Second)
+	suite.requireSignal(timer.C(), Immediate)
+	suite.requireSignal(ticker.C(), Immediate)
+	suite.requireSignal(done, WaitALittle)
+
+	// the ticker continues on its interval
+	fc.Add(time.Hour)
+	suite.requireSignal(ticker.C(), Immediate)
+	ticker.Stop()
+}
+
+func (suite *SwitchableClockSuite) TestMigrateToSystem() {
+	var (
+		sc     = suite.newSwitchableClock()
+		fc     = sc.Fake()
+		short  = sc.NewTimer(time.Hour + 10*time.Millisecond)
+		long   = sc.NewTimer(2 * time.Hour)
+		ticker = sc.NewTicker(time.Hour + 10*time.Millisecond)
+	)
+
+	fc.Add(time.Hour)
+	suite.requireNoSignal(short.C(), Immediate)
+	sc.System()
+
+	// the timeline has changed, so the fake clock no longer has any effect
+	fc.Add(2 * time.Hour)
+	suite.requireSignal(short.C(), WaitALittle)
+	suite.requireSignal(ticker.C(), WaitALittle)
+	suite.requireNoSignal(long.C(), Immediate)
+	suite.True(long.Stop())
+	ticker.Stop()
+}
+
+func TestSwitchableClock(t *testing.T) {
+	suite.Run(t, new(SwitchableClockSuite))
+}
@@ -86,7 +86,24 @@ func (sc systemClock) NewTimer(d time.Duration) Timer {
 	}
 }
 
+func (sc systemClock) NewTimerAt(t time.Time) Timer {
+	return sc.NewTimer(time.Until(t))
+}
+
+func (sc systemClock) AfterFuncAt(t time.Time, f func()) Timer {
+	return sc.AfterFunc(time.Until(t), f)
+}
+
+func (sc systemClock) AfterAt(t time.Time) <-chan time.Time {
+	return time.After(time.Until(t))
+}
+
+func (sc systemClock) SleepUntil(t time.Time) {
+	time.Sleep(time.Until(t))
+}
+
 // SystemClock returns a Clock implementation backed by the time package.
+// The returned Clock also implements ClockAt.
 func SystemClock() Clock {
 	return systemClock{}
 }
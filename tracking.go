@@ -0,0 +1,417 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Kind identifies how a tracked object was created.
+type Kind string
+
+const (
+	// KindTimer is a Timer created with NewTimer.
+	KindTimer Kind = "timer"
+
+	// KindAfter is the implicit Timer created by After.
+	KindAfter Kind = "after"
+
+	// KindAfterFunc is a Timer created with AfterFunc.
+	KindAfterFunc Kind = "afterFunc"
+
+	// KindTicker is a Ticker created with NewTicker.
+	KindTicker Kind = "ticker"
+
+	// KindTick is the implicit Ticker created by Tick.  Such a ticker can
+	// never be stopped.
+	KindTick Kind = "tick"
+
+	// KindSleep is a goroutine blocked in Sleep.
+	KindSleep Kind = "sleep"
+)
+
+// Site is the location in code where a tracked object was created.
+type Site struct {
+	Function string `json:"function"`
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+}
+
+// String returns the file and line of this Site, which uniquely identifies it.
+func (s Site) String() string {
+	return fmt.Sprintf("%s:%d", s.File, s.Line)
+}
+
+// callSite returns the Site of the code that invoked a TrackingClock method.
+func callSite() (s Site) {
+	// skip callSite and the TrackingClock method
+	pc, file, line, ok := runtime.Caller(2)
+	if ok {
+		s.File, s.Line = file, line
+		if f := runtime.FuncForPC(pc); f != nil {
+			s.Function = f.Name()
+		}
+	}
+
+	return
+}
+
+// Tracked describes a live object created through a TrackingClock.
+type Tracked struct {
+	// ID uniquely identifies this object within its TrackingClock.
+	ID uint64 `json:"id"`
+
+	// Kind is how this object was created.
+	Kind Kind `json:"kind"`
+
+	// Site is where this object was created.
+	Site Site `json:"site"`
+
+	// Created is the clock time at which this object was created.
+	Created time.Time `json:"created"`
+
+	// Interval is the duration passed when this object was created or last reset.
+	Interval time.Duration `json:"interval"`
+
+	// When is the next time at which this object fires.  For timers that have
+	// already fired, this is the time at which they fired.
+	When time.Time `json:"when"`
+
+	// Fired indicates that this object is a timer that has fired but whose
+	// event has not been received from its channel.
+	Fired bool `json:"fired"`
+
+	// Unreceived is the time at which this object sent an event that has not yet been
+	// received from its channel.  This is the zero time if there is no such event.
+	Unreceived time.Time `json:"unreceived"`
+}
+
+// trackedEntry is the internal, mutable state for a tracked object.
+type trackedEntry struct {
+	Tracked
+
+	// c is the unbuffered channel on which the object's events are relayed, if any.
+	c chan time.Time
+
+	// abandon is closed to abandon the event currently being relayed, if any.
+	abandon chan struct{}
+}
+
+// discard abandons any event being relayed for this entry.  This method
+// must be invoked under the TrackingClock's lock.
+func (e *trackedEntry) discard() {
+	if e.abandon != nil {
+		close(e.abandon)
+		e.abandon = nil
+	}
+
+	e.Fired = false
+	e.Unreceived = time.Time{}
+}
+
+// TrackingClock is a Clock decorator that records every live Timer, Ticker, and AfterFunc
+// created through it, as well as each goroutine currently in Sleep.  This is useful for
+// diagnosing leaked timers and tickers in production.  Use Tracked to inspect the live objects.
+//
+// A timer remains live until it is stopped or until it fires and its event is received.
+// A ticker remains live until it is stopped.
+//
+// Timers and tickers are driven by AfterFunc on the base clock, and each event is relayed
+// on an unbuffered channel by its own goroutine, which records when the event is received.
+// This works the same regardless of the Go version's timer channel semantics.  As with a
+// time.Ticker, a ticker drops events while its previous event is unreceived.  Stopping or
+// resetting a timer or ticker discards any unreceived event.
+type TrackingClock struct {
+	base Clock
+
+	lock    sync.Mutex
+	lastID  uint64
+	entries map[uint64]*trackedEntry
+}
+
+var _ Clock = (*TrackingClock)(nil)
+
+// NewTrackingClock creates a TrackingClock that decorates the given base Clock.
+// If base is nil, SystemClock() is used.
+func NewTrackingClock(base Clock) *TrackingClock {
+	if base == nil {
+		base = SystemClock()
+	}
+
+	return &TrackingClock{
+		base:    base,
+		entries: make(map[uint64]*trackedEntry),
+	}
+}
+
+// Tracked returns a snapshot of the live objects created through this clock, ordered by ID.
+func (tc *TrackingClock) Tracked() []Tracked {
+	_, entries := tc.sample()
+	tracked := make([]Tracked, len(entries))
+	for i, e := range entries {
+		tracked[i] = e.Tracked
+	}
+
+	return tracked
+}
+
+// sample inspects each live object as of the base clock's current time.  The current
+// time is returned along with copies of the entries, ordered by ID.
+func (tc *TrackingClock) sample() (now time.Time, entries []trackedEntry) {
+	now = tc.base.Now()
+
+	tc.lock.Lock()
+	defer tc.lock.Unlock()
+
+	entries = make([]trackedEntry, 0, len(tc.entries))
+	for _, e := range tc.entries {
+		// report the next tick after the current time
+		if (e.Kind == KindTicker || e.Kind == KindTick) && !e.When.After(now) {
+			ticks := now.Sub(e.When)/e.Interval + 1
+			e.When = e.When.Add(ticks * e.Interval)
+		}
+
+		entries = append(entries, *e)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ID < entries[j].ID
+	})
+
+	return
+}
+
+// track records a new live object.
+func (tc *TrackingClock) track(kind Kind, site Site, d time.Duration, c chan time.Time) *trackedEntry {
+	now := tc.base.Now()
+
+	tc.lock.Lock()
+	defer tc.lock.Unlock()
+
+	tc.lastID++
+	e := &trackedEntry{
+		Tracked: Tracked{
+			ID:       tc.lastID,
+			Kind:     kind,
+			Site:     site,
+			Created:  now,
+			Interval: d,
+			When:     now.Add(d),
+		},
+		c: c,
+	}
+
+	tc.entries[e.ID] = e
+	return e
+}
+
+// reset updates and, if necessary, reactivates a tracked object.  This method
+// returns true if the object was live.
+func (tc *TrackingClock) reset(e *trackedEntry, d time.Duration) (live bool) {
+	now := tc.base.Now()
+
+	tc.lock.Lock()
+	live = tc.entries[e.ID] == e
+	e.discard()
+	e.Interval = d
+	e.When = now.Add(d)
+	tc.entries[e.ID] = e
+	tc.lock.Unlock()
+	return
+}
+
+// untrack removes a tracked object.  This method returns true if the object was live.
+func (tc *TrackingClock) untrack(e *trackedEntry) (live bool) {
+	tc.lock.Lock()
+	live = tc.entries[e.ID] == e
+	e.discard()
+	delete(tc.entries, e.ID)
+	tc.lock.Unlock()
+	return
+}
+
+// deliver relays an event sent by a tracked object at the given time.  The event is
+// dropped if the object is no longer live or if its previous event is unreceived.
+func (tc *TrackingClock) deliver(e *trackedEntry, now time.Time) {
+	tc.lock.Lock()
+	defer tc.lock.Unlock()
+
+	if tc.entries[e.ID] != e || e.abandon != nil {
+		return
+	}
+
+	e.abandon = make(chan struct{})
+	e.Fired = e.Kind == KindTimer || e.Kind == KindAfter
+	e.Unreceived = now
+	go tc.relay(e, now, e.abandon)
+}
+
+// relay sends an event on an entry's channel, recording its receipt.  A timer whose
+// event is received is no longer live.
+func (tc *TrackingClock) relay(e *trackedEntry, now time.Time, abandon chan struct{}) {
+	// the event may have been abandoned before this goroutine started
+	select {
+	case <-abandon:
+		return
+
+	default:
+	}
+
+	select {
+	case e.c <- now:
+		tc.lock.Lock()
+		if e.abandon == abandon {
+			e.abandon = nil
+			e.Unreceived = time.Time{}
+			if e.Fired {
+				delete(tc.entries, e.ID)
+			}
+		}
+
+		tc.lock.Unlock()
+
+	case <-abandon:
+	}
+}
+
+func (tc *TrackingClock) Now() time.Time {
+	return tc.base.Now()
+}
+
+func (tc *TrackingClock) Since(t time.Time) time.Duration {
+	return tc.base.Since(t)
+}
+
+func (tc *TrackingClock) Until(t time.Time) time.Duration {
+	return tc.base.Until(t)
+}
+
+func (tc *TrackingClock) Sleep(d time.Duration) {
+	e := tc.track(KindSleep, callSite(), d, nil)
+	defer tc.untrack(e)
+	tc.base.Sleep(d)
+}
+
+func (tc *TrackingClock) After(d time.Duration) <-chan time.Time {
+	e := tc.track(KindAfter, callSite(), d, make(chan time.Time))
+	tc.base.AfterFunc(d, func() { tc.deliver(e, tc.base.Now()) })
+	return e.c
+}
+
+func (tc *TrackingClock) AfterFunc(d time.Duration, f func()) Timer {
+	tt := &trackedTimer{
+		tc: tc,
+	}
+
+	tt.e = tc.track(KindAfterFunc, callSite(), d, nil)
+	tt.Timer = tc.base.AfterFunc(d, func() {
+		tc.untrack(tt.e)
+		f()
+	})
+
+	return tt
+}
+
+func (tc *TrackingClock) Tick(d time.Duration) <-chan time.Time {
+	if d <= 0 {
+		// consistent with time.Tick
+		return nil
+	}
+
+	return tc.newTicker(KindTick, callSite(), d).C()
+}
+
+func (tc *TrackingClock) NewTicker(d time.Duration) Ticker {
+	return tc.newTicker(KindTicker, callSite(), d)
+}
+
+// newTicker creates a tracked ticker of the given kind.
+func (tc *TrackingClock) newTicker(kind Kind, site Site, d time.Duration) *trackedTicker {
+	tt := &trackedTicker{
+		tc: tc,
+		e:  tc.track(kind, site, d, make(chan time.Time)),
+	}
+
+	// the observed ticker has no channel, since ticks are relayed on the entry's channel
+	tt.observedTicker = newObservedTicker(tc.base, nil, d, d, func(_, now time.Time) {
+		tc.deliver(tt.e, now)
+	})
+
+	return tt
+}
+
+func (tc *TrackingClock) NewTimer(d time.Duration) Timer {
+	tt := &trackedTimer{
+		tc: tc,
+	}
+
+	tt.e = tc.track(KindTimer, callSite(), d, make(chan time.Time))
+	tt.Timer = tc.base.AfterFunc(d, func() {
+		tc.deliver(tt.e, tc.base.Now())
+	})
+
+	return tt
+}
+
+// trackedTimer is a Timer created through a TrackingClock.
+type trackedTimer struct {
+	Timer
+	tc *TrackingClock
+	e  *trackedEntry
+}
+
+func (tt *trackedTimer) C() <-chan time.Time {
+	return tt.e.c
+}
+
+// Reset reschedules this timer.  As with Stop, for a timer with a channel this
+// method returns true if any unreceived event was discarded.
+func (tt *trackedTimer) Reset(d time.Duration) bool {
+	live := tt.tc.reset(tt.e, d)
+	active := tt.Timer.Reset(d)
+	if tt.e.c != nil {
+		return live
+	}
+
+	return active
+}
+
+// Stop stops this timer.  For a timer with a channel, this method returns true unless
+// the timer's event was already received or the timer was already stopped.  Since an
+// unreceived event is discarded, this is consistent with time.Timer as of Go 1.23, and
+// the idiom of draining the channel when Stop returns false never blocks.
+func (tt *trackedTimer) Stop() bool {
+	live := tt.tc.untrack(tt.e)
+	active := tt.Timer.Stop()
+	if tt.e.c != nil {
+		return live
+	}
+
+	return active
+}
+
+// trackedTicker is a Ticker created through a TrackingClock.
+type trackedTicker struct {
+	*observedTicker
+	tc *TrackingClock
+	e  *trackedEntry
+}
+
+func (tt *trackedTicker) C() <-chan time.Time {
+	return tt.e.c
+}
+
+func (tt *trackedTicker) Reset(d time.Duration) {
+	tt.observedTicker.Reset(d)
+	tt.tc.reset(tt.e, d)
+}
+
+func (tt *trackedTicker) Stop() {
+	tt.tc.untrack(tt.e)
+	tt.observedTicker.Stop()
+}
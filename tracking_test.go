@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: 2024 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package chronon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TrackingClockSuite struct {
+	ChrononSuite
+}
+
+func (suite *TrackingClockSuite) newTrackingClock() (*TrackingClock, *FakeClock) {
+	fc := suite.newFakeClock()
+	tc := NewTrackingClock(fc)
+	suite.Require().NotNil(tc)
+	suite.Empty(tc.Tracked())
+	return tc, fc
+}
+
+// requireTracked asserts that exactly one object of the given kind is tracked and returns it.
+func (suite *TrackingClockSuite) requireTracked(tc *TrackingClock, kind Kind) Tracked {
+	suite.T().Helper()
+	tracked := tc.Tracked()
+	suite.Require().Len(tracked, 1)
+	suite.Require().Equal(kind, tracked[0].Kind)
+	suite.Contains(tracked[0].Site.File, "tracking_test.go")
+	suite.Contains(tracked[0].Site.Function, "TrackingClockSuite")
+	suite.Positive(tracked[0].Site.Line)
+	return tracked[0]
+}
+
+// requireUntracked waits for the given TrackingClock to have no live objects.
+func (suite *TrackingClockSuite) requireUntracked(tc *TrackingClock) {
+	suite.T().Helper()
+	suite.Eventually(
+		func() bool { return len(tc.Tracked()) == 0 },
+		time.Second,
+		time.Millisecond,
+	)
+}
+
+func (suite *TrackingClockSuite) TestDefault() {
+	tc := NewTrackingClock(nil)
+	suite.True(IsSystemClock(tc.base))
+}
+
+func (suite *TrackingClockSuite) TestNow() {
+	tc, fc := suite.newTrackingClock()
+	suite.Equal(fc.Now(), tc.Now())
+	suite.Equal(time.Second, tc.Until(suite.now.Add(time.Second)))
+	suite.Equal(time.Second, tc.Since(suite.now.Add(-time.Second)))
+}
+
+func (suite *TrackingClockSuite) TestNewTimer() {
+	tc, fc := suite.newTrackingClock()
+	t := tc.NewTimer(TestInterval)
+
+	tracked := suite.requireTracked(tc, KindTimer)
+	suite.Equal(TestInterval, tracked.Interval)
+	suite.Equal(suite.now, tracked.Created)
+	suite.Equal(suite.now.Add(TestInterval), tracked.When)
+	suite.False(tracked.Fired)
+
+	// a fired timer remains tracked until its event is received
+	fc.Add(TestInterval)
+	tracked = suite.requireTracked(tc, KindTimer)
+	suite.True(tracked.Fired)
+	suite.Equal(fc.Now(), tracked.Unreceived)
+	suite.requireSignal(t.C(), WaitALittle)
+	suite.requireUntracked(tc)
+
+	suite.False(t.Reset(TestInterval))
+	tracked = suite.requireTracked(tc, KindTimer)
+	suite.Equal(fc.Now().Add(TestInterval), tracked.When)
+	suite.False(tracked.Fired)
+
+	suite.True(t.Stop())
+	suite.Empty(tc.Tracked())
+}
+
+func (suite *TrackingClockSuite) TestStopDiscards() {
+	tc, fc := suite.newTrackingClock()
+	t := tc.NewTimer(TestInterval)
+
+	fc.Add(TestInterval)
+	suite.True(suite.requireTracked(tc, KindTimer).Fired)
+
+	// stopping a fired timer discards its unreceived event
+	suite.True(t.Stop())
+	suite.Empty(tc.Tracked())
+	suite.requireNoSignal(t.C(), WaitALittle)
+	suite.False(t.Stop())
+}
+
+// drain stops a timer with the common idiom, failing if the idiom blocks.
+func (suite *TrackingClockSuite) drain(t Timer) {
+	suite.T().Helper()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if !t.Stop() {
+			<-t.C()
+		}
+	}()
+
+	suite.requireSignal(done, WaitALittle)
+}
+
+func (suite *TrackingClockSuite) TestDrainIdiom() {
+	tc, fc := suite.newTrackingClock()
+	t := tc.NewTimer(TestInterval)
+
+	// unfired
+	suite.drain(t)
+
+	// fired, but unreceived
+	t.Reset(TestInterval)
+	fc.Add(TestInterval)
+	suite.drain(t)
+
+	// a reset after draining works normally
+	t.Reset(TestInterval)
+	fc.Add(TestInterval)
+	suite.requireSignal(t.C(), WaitALittle)
+	suite.requireUntracked(tc)
+}
+
+func (suite *TrackingClockSuite) TestDrainIdiomSystemClock() {
+	tc := NewTrackingClock(nil)
+	for i := 0; i < 20; i++ {
+		t := tc.NewTimer(time.Millisecond)
+		time.Sleep(time.Duration(i%3) * time.Millisecond)
+		suite.drain(t)
+
+		t.Reset(time.Millisecond)
+		suite.requireSignal(t.C(), WaitALittle)
+	}
+
+	suite.requireUntracked(tc)
+}
+
+func (suite *TrackingClockSuite) TestAfter() {
+	tc, fc := suite.newTrackingClock()
+	ch := tc.After(TestInterval)
+	suite.requireTracked(tc, KindAfter)
+
+	fc.Add(TestInterval)
+	suite.requireSignal(ch, WaitALittle)
+	suite.requireUntracked(tc)
+}
+
+func (suite *TrackingClockSuite) TestAfterFunc() {
+	tc, fc := suite.newTrackingClock()
+	called := make(chan struct{}, 1)
+	t := tc.AfterFunc(TestInterval, func() { called <- struct{}{} })
+	suite.requireTracked(tc, KindAfterFunc)
+
+	fc.Add(TestInterval)
+	suite.requireSignal(called, Immediate)
+	suite.Empty(tc.Tracked())
+
+	t.Reset(TestInterval)
+	suite.requireTracked(tc, KindAfterFunc)
+	t.Stop()
+	suite.Empty(tc.Tracked())
+}
+
+func (suite *TrackingClockSuite) TestNewTicker() {
+	tc, fc := suite.newTrackingClock()
+	t := tc.NewTicker(TestInterval)
+	suite.requireTracked(tc, KindTicker)
+
+	// the next tick is reported relative to the current time
+	fc.Add(TestInterval + TestInterval/2)
+	tracked := suite.requireTracked(tc, KindTicker)
+	suite.Equal(suite.now.Add(2*TestInterval), tracked.When)
+	suite.Equal(fc.Now(), tracked.Unreceived)
+	suite.False(tracked.Fired)
+
+	// receiving the tick is recorded
+	suite.requireSignal(t.C(), WaitALittle)
+	suite.Eventually(
+		func() bool { return suite.requireTracked(tc, KindTicker).Unreceived.IsZero() },
+		time.Second,
+		time.Millisecond,
+	)
+
+	// resetting discards an unreceived tick
+	fc.Add(TestInterval)
+	suite.False(suite.requireTracked(tc, KindTicker).Unreceived.IsZero())
+	t.Reset(time.Hour)
+	tracked = suite.requireTracked(tc, KindTicker)
+	suite.Equal(time.Hour, tracked.Interval)
+	suite.Equal(fc.Now().Add(time.Hour), tracked.When)
+	suite.True(tracked.Unreceived.IsZero())
+	suite.requireNoSignal(t.C(), WaitALittle)
+
+	t.Stop()
+	suite.Empty(tc.Tracked())
+}
+
+func (suite *TrackingClockSuite) TestTick() {
+	tc, fc := suite.newTrackingClock()
+	suite.Nil(tc.Tick(0))
+	suite.Empty(tc.Tracked())
+
+	ch := tc.Tick(TestInterval)
+	suite.NotNil(ch)
+	suite.requireTracked(tc, KindTick)
+
+	fc.Add(TestInterval)
+	suite.requireSignal(ch, WaitALittle)
+	suite.requireTracked(tc, KindTick)
+}
+
+func (suite *TrackingClockSuite) TestSleep() {
+	tc, fc := suite.newTrackingClock()
+	onSleep := make(chan Sleeper, 1)
+	fc.NotifyOnSleep(onSleep)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		tc.Sleep(TestInterval)
+	}()
+
+	s := suite.requireReceive(onSleep, WaitALittle).(Sleeper)
+	tracked := tc.Tracked()
+	suite.Require().Len(tracked, 1)
+	suite.Equal(KindSleep, tracked[0].Kind)
+
+	fc.Set(s.When())
+	suite.requireSignal(done, WaitALittle)
+	suite.Empty(tc.Tracked())
+}
+
+func TestTrackingClock(t *testing.T) {
+	suite.Run(t, new(TrackingClockSuite))
+}
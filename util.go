@@ -21,3 +21,12 @@ func sendTime(c chan<- time.Time, t time.Time) {
 	default:
 	}
 }
+
+// drainTime does a nonblocking receive on a time channel, discarding any
+// value that was sent but never received.
+func drainTime(c chan time.Time) {
+	select {
+	case <-c:
+	default:
+	}
+}